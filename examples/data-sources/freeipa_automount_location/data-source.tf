@@ -0,0 +1,11 @@
+data "freeipa_automount_location" "paris" {
+  name = "paris"
+}
+
+output "automount_maps" {
+  value = {
+    for m in data.freeipa_automount_location.paris.maps : m.name => {
+      for k in m.keys : k.key => k.information
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "freeipa_hbac_service" "sshd" {
+  name = "sshd"
+}
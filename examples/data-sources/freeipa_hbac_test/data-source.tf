@@ -0,0 +1,13 @@
+data "freeipa_hbac_test" "jdoe_ssh_web01" {
+  user       = "jdoe"
+  targethost = "web01.example.lan"
+  service    = "sshd"
+  rules      = [freeipa_hbac_policy.web_admins.name]
+}
+
+check "jdoe_can_ssh_web01" {
+  assert {
+    condition     = data.freeipa_hbac_test.jdoe_ssh_web01.access_granted
+    error_message = "jdoe is not allowed to ssh to web01: ${join(", ", data.freeipa_hbac_test.jdoe_ssh_web01.notmatched_rules)}"
+  }
+}
@@ -0,0 +1,7 @@
+data "freeipa_password_policy" "global" {
+  name = "global_policy"
+}
+
+data "freeipa_password_policy" "jdoe" {
+  user = "jdoe"
+}
@@ -0,0 +1,3 @@
+data "freeipa_privilege" "user-admins" {
+  name = "User Administrators"
+}
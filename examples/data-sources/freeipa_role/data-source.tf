@@ -0,0 +1,3 @@
+data "freeipa_role" "helpdesk" {
+  name = "helpdesk"
+}
@@ -0,0 +1,3 @@
+data "freeipa_service" "http" {
+  name = "HTTP/web.example.test"
+}
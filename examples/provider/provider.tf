@@ -13,3 +13,81 @@ provider "freeipa" {
   password = "123456789"
   insecure = true
 }
+
+# Kerberos authentication with a keytab
+provider "freeipa" {
+  alias     = "keytab"
+  host      = "ipa.example.test"
+  principal = "terraform@EXAMPLE.TEST"
+  keytab    = "/etc/terraform.keytab"
+}
+
+# Kerberos authentication with the ticket obtained by kinit
+provider "freeipa" {
+  alias      = "ccache"
+  host       = "ipa.example.test"
+  use_ccache = true
+}
+
+# Certificate authentication with a certificate issued by the IPA CA
+provider "freeipa" {
+  alias              = "certificate"
+  host               = "ipa.example.test"
+  client_certificate = "/etc/pki/terraform/terraform.crt"
+  client_key         = "/etc/pki/terraform/terraform.key"
+}
+
+# Fail over to the replicas when the first server is unreachable
+provider "freeipa" {
+  alias    = "replicas"
+  host     = "ipa1.example.test"
+  hosts    = ["ipa2.example.test", "ipa3.example.test"]
+  username = "admin"
+  password = "123456789"
+}
+
+# Discover the servers from the DNS SRV records of the IPA domain
+provider "freeipa" {
+  alias            = "discovery"
+  discovery_domain = "example.test"
+  username         = "admin"
+  password         = "123456789"
+}
+
+# Retry transient errors and entries not yet replicated
+provider "freeipa" {
+  alias    = "retry"
+  host     = "ipa.example.test"
+  username = "admin"
+  password = "123456789"
+  retry = {
+    max_attempts          = 5
+    min_backoff           = "1s"
+    max_backoff           = "30s"
+    timeout               = "2m"
+    retryable_error_codes = [4001]
+  }
+}
+
+# Protect a shared IPA server from a high Terraform parallelism
+provider "freeipa" {
+  alias                   = "limited"
+  host                    = "ipa.example.test"
+  username                = "admin"
+  password                = "123456789"
+  max_concurrent_requests = 4
+  requests_per_second     = 10
+}
+
+# Reach IPA through a corporate proxy, with a CA certificate read from Vault
+provider "freeipa" {
+  alias              = "proxied"
+  host               = "ipa.example.test"
+  username           = "admin"
+  password           = "123456789"
+  proxy_url          = "http://proxy.example.test:3128"
+  ca_certificate_pem = data.vault_generic_secret.ipa.data["ca_certificate"]
+  extra_headers = {
+    "X-Proxy-Authorization" = "Bearer 0123456789"
+  }
+}
@@ -0,0 +1,7 @@
+# The import id uses the format: <location>/<map_name>/<key>
+# The key of a direct map is an absolute path, it is kept as is at the end of the id.
+
+import {
+  to = freeipa_automount_key.share
+  id = "paris/auto.direct//share"
+}
@@ -0,0 +1,13 @@
+resource "freeipa_automount_key" "home" {
+  location    = freeipa_automount_location.paris.name
+  map         = freeipa_automount_map.home.name
+  key         = "*"
+  information = "-fstype=nfs4,rw nfs.example.lan:/export/home/&"
+}
+
+resource "freeipa_automount_key" "share" {
+  location    = freeipa_automount_location.paris.name
+  map         = "auto.direct"
+  key         = "/share"
+  information = "-fstype=nfs4,ro nfs.example.lan:/export/share"
+}
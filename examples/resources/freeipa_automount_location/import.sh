@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the automount location.
+
+import {
+  to = freeipa_automount_location.paris
+  id = "paris"
+}
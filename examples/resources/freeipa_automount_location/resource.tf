@@ -0,0 +1,3 @@
+resource "freeipa_automount_location" "paris" {
+  name = "paris"
+}
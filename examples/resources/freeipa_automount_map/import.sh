@@ -0,0 +1,7 @@
+# The import id uses the format: <location>/<map_name>
+# The mount point and parent map of an indirect map are found from the key referencing the map.
+
+import {
+  to = freeipa_automount_map.home
+  id = "paris/auto.home"
+}
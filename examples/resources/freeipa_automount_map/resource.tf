@@ -0,0 +1,11 @@
+resource "freeipa_automount_map" "home" {
+  location    = freeipa_automount_location.paris.name
+  name        = "auto.home"
+  description = "Home directories"
+  mount       = "/home"
+}
+
+resource "freeipa_automount_map" "share" {
+  location = freeipa_automount_location.paris.name
+  name     = "auto.share"
+}
@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the certificate authority.
+
+import {
+  to = freeipa_ca.vpn
+  id = "vpn-ca"
+}
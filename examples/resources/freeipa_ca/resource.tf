@@ -0,0 +1,5 @@
+resource "freeipa_ca" "vpn" {
+  name        = "vpn-ca"
+  description = "Sub-CA issuing the VPN certificates"
+  subject     = "CN=VPN CA,O=EXAMPLE.LAN"
+}
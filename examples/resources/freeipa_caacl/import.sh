@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the CA ACL.
+
+import {
+  to = freeipa_caacl.vpn
+  id = "vpn-servers"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_caacl" "vpn" {
+  name            = "vpn-servers"
+  description     = "VPN servers can request certificates from the VPN sub-CA"
+  enabled         = true
+  servicecategory = "all"
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <caacl_name>/<type>/<identifier>
+# Use type "c" for a single certificate authority membership, the identifier being the CA name.
+# Use type "mc" for multi-CA membership, all the current members of the CA ACL are imported.
+
+import {
+  to = freeipa_caacl_ca_membership.vpn-ca
+  id = "vpn-servers/c/vpn-ca"
+}
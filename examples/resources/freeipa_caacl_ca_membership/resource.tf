@@ -0,0 +1,4 @@
+resource "freeipa_caacl_ca_membership" "vpn-ca" {
+  name = "vpn-servers"
+  ca   = freeipa_ca.vpn.name
+}
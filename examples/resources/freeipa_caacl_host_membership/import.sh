@@ -0,0 +1,8 @@
+# The import id uses the format: <caacl_name>/<type>/<identifier>
+# Use type "h" or "hg" for a single host or host group membership, the identifier being the member name.
+# Use type "mh" for multi-host/hostgroup membership, all the current members of the CA ACL are imported.
+
+import {
+  to = freeipa_caacl_host_membership.vpn-hostgroups
+  id = "vpn-servers/mh/vpn-hostgroups"
+}
@@ -0,0 +1,10 @@
+resource "freeipa_caacl_host_membership" "vpn-host-1" {
+  name = "vpn-servers"
+  host = "vpn-1.example.lan"
+}
+
+resource "freeipa_caacl_host_membership" "vpn-hostgroups" {
+  name       = "vpn-servers"
+  hostgroups = ["vpn-gateways"]
+  identifier = "vpn-hostgroups"
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <caacl_name>/<type>/<identifier>
+# Use type "p" for a single certificate profile membership, the identifier being the profile name.
+# Use type "mp" for multi-profile membership, all the current members of the CA ACL are imported.
+
+import {
+  to = freeipa_caacl_profile_membership.vpn-profile
+  id = "vpn-servers/p/vpnServerCert"
+}
@@ -0,0 +1,4 @@
+resource "freeipa_caacl_profile_membership" "vpn-profile" {
+  name    = "vpn-servers"
+  profile = freeipa_certprofile.vpn.name
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <caacl_name>/<type>/<identifier>
+# Use type "s" for a single service membership, the identifier being the service principal.
+# Use type "ms" for multi-service membership, all the current members of the CA ACL are imported.
+
+import {
+  to = freeipa_caacl_service_membership.vpn-services
+  id = "vpn-servers/ms/vpn-services"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_caacl_service_membership" "vpn-services" {
+  name       = "vpn-servers"
+  services   = ["openvpn/vpn-1.example.lan", "openvpn/vpn-2.example.lan"]
+  identifier = "vpn-services"
+}
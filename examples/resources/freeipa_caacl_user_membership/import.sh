@@ -0,0 +1,8 @@
+# The import id uses the format: <caacl_name>/<type>/<identifier>
+# Use type "u" or "g" for a single user or user group membership, the identifier being the member name.
+# Use type "mu" for multi-user/group membership, all the current members of the CA ACL are imported.
+
+import {
+  to = freeipa_caacl_user_membership.vpn-groups
+  id = "vpn-servers/mu/vpn-groups"
+}
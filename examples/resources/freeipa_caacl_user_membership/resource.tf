@@ -0,0 +1,10 @@
+resource "freeipa_caacl_user_membership" "vpn-user-1" {
+  name = "vpn-servers"
+  user = "user-1"
+}
+
+resource "freeipa_caacl_user_membership" "vpn-groups" {
+  name       = "vpn-servers"
+  groups     = ["vpn-admins", "network-admins"]
+  identifier = "vpn-groups"
+}
@@ -0,0 +1,11 @@
+resource "freeipa_service" "http" {
+  name = "HTTP/web.example.lan"
+}
+
+resource "freeipa_certificate" "http" {
+  principal         = freeipa_service.http.name
+  csr               = file("web.example.lan.csr")
+  profile_id        = "caIPAserviceCert"
+  renewal_window    = 30
+  revocation_reason = 4
+}
@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the name of the certificate profile.
+# The profile configuration is not imported, the next apply updates it from the config attribute.
+
+import {
+  to = freeipa_certprofile.vpn
+  id = "vpnServerCert"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_certprofile" "vpn" {
+  name        = "vpnServerCert"
+  description = "VPN server certificates"
+  config      = file("vpnServerCert.cfg")
+  store       = true
+}
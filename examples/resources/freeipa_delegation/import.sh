@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the delegation rule.
+
+import {
+  to = freeipa_delegation.helpdesk
+  id = "Helpdesk can manage phone numbers"
+}
@@ -0,0 +1,7 @@
+resource "freeipa_delegation" "helpdesk" {
+  name         = "Helpdesk can manage phone numbers"
+  permissions  = ["write"]
+  attributes   = ["telephonenumber", "mobile"]
+  group        = "helpdesk"
+  member_group = "ipausers"
+}
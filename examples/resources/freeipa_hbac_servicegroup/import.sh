@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the HBAC service group.
+
+import {
+  to = freeipa_hbac_servicegroup.remote_access
+  id = "remote-access"
+}
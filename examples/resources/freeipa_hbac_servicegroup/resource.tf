@@ -0,0 +1,4 @@
+resource "freeipa_hbac_servicegroup" "remote_access" {
+  name        = "remote-access"
+  description = "Services used to access the hosts remotely"
+}
@@ -0,0 +1,9 @@
+# The import id uses the format: <servicegroup_name>/<type>/<identifier>
+# Use type "s" for a single HBAC service membership, the identifier being the service name.
+# Use type "ms" for multi-service membership, all the current members of the group are imported.
+# Note: slash characters in the service group name must be percent-encoded (%2F).
+
+import {
+  to = freeipa_hbac_servicegroup_membership.remote_access_shells
+  id = "remote-access/ms/remote_access_shells"
+}
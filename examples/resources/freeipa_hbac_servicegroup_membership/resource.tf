@@ -0,0 +1,20 @@
+resource "freeipa_hbac_service" "cockpit" {
+  name        = "cockpit"
+  description = "Cockpit web console"
+}
+
+resource "freeipa_hbac_servicegroup" "remote_access" {
+  name        = "remote-access"
+  description = "Services used to access the hosts remotely"
+}
+
+resource "freeipa_hbac_servicegroup_membership" "remote_access_cockpit" {
+  name    = freeipa_hbac_servicegroup.remote_access.id
+  service = freeipa_hbac_service.cockpit.id
+}
+
+resource "freeipa_hbac_servicegroup_membership" "remote_access_shells" {
+  name       = freeipa_hbac_servicegroup.remote_access.id
+  services   = ["sshd", "login"]
+  identifier = "remote_access_shells"
+}
@@ -0,0 +1,12 @@
+# The import id uses the format: <host_name>/<type>/<member>
+# Use type "u" for a user, "g" for a group, "h" for a host and "hg" for a hostgroup.
+
+import {
+  to = freeipa_host_allow_retrieve_keytab.admins
+  id = "web.example.test/g/admins"
+}
+
+resource "freeipa_host_allow_retrieve_keytab" "admins" {
+  name  = "web.example.test"
+  group = "admins"
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "freeipa_host_allow_retrieve_keytab" "admins" {
+  name  = "web.example.test"
+  group = "admins"
+}
+
+resource "freeipa_host_allow_retrieve_keytab" "automation" {
+  name       = "web.example.test"
+  users      = ["automation"]
+  hostgroups = ["deploy-servers"]
+  identifier = "automation"
+}
@@ -0,0 +1,6 @@
+# The import id uses the format: <idview_name>/<anchor>
+
+import {
+  to = freeipa_idoverride_group.admins
+  id = "ad-users/admins"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_idoverride_group" "admins" {
+  idview     = freeipa_idview.ad-users.name
+  anchor     = "admins"
+  name       = "legacy-admins"
+  gid_number = 20000
+}
@@ -0,0 +1,8 @@
+resource "freeipa_idoverride_user" "jdoe" {
+  idview         = freeipa_idview.ad-users.name
+  anchor         = "jdoe"
+  uid_number     = 20001
+  gid_number     = 20001
+  home_directory = "/export/home/jdoe"
+  login_shell    = "/bin/bash"
+}
@@ -0,0 +1,8 @@
+# The import id must be exactly the same as the name of the identity provider.
+# The provider type, organization, base URL and client secret cannot be read back from FreeIPA.
+# When set in the configuration, they are recorded on the next apply without replacing the identity provider.
+
+import {
+  to = freeipa_idp.keycloak
+  id = "keycloak"
+}
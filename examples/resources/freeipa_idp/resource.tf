@@ -0,0 +1,32 @@
+variable "keycloak_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_idp" "keycloak" {
+  name          = "keycloak"
+  provider_type = "keycloak"
+  organization  = "example"
+  base_url      = "keycloak.example.lan:8443"
+  client_id     = "freeipa"
+  secret        = var.keycloak_client_secret
+  idp_user_id   = "email"
+}
+
+resource "freeipa_idp" "custom" {
+  name         = "custom-idp"
+  auth_uri     = "https://idp.example.lan/oauth2/authorize"
+  dev_auth_uri = "https://idp.example.lan/oauth2/device"
+  token_uri    = "https://idp.example.lan/oauth2/token"
+  userinfo_uri = "https://idp.example.lan/oauth2/userinfo"
+  client_id    = "freeipa"
+}
+
+resource "freeipa_user" "remote" {
+  name                  = "remote-user"
+  first_name            = "Remote"
+  last_name             = "User"
+  auth_type             = ["idp"]
+  external_idp_config   = freeipa_idp.keycloak.name
+  external_idp_username = "remote-user@example.lan"
+}
@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the ID view.
+
+import {
+  to = freeipa_idview.ad-users
+  id = "ad-users"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_idview" "ad-users" {
+  name                    = "ad-users"
+  description             = "Overrides for AD users on legacy hosts"
+  domain_resolution_order = "ad.example.lan:ipa.example.lan"
+}
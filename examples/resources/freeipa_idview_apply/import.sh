@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the name of the ID view.
+# All the hosts the ID view is currently applied to are imported.
+
+import {
+  to = freeipa_idview_apply.ad-users
+  id = "ad-users"
+}
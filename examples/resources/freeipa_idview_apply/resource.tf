@@ -0,0 +1,5 @@
+resource "freeipa_idview_apply" "ad-users" {
+  name       = freeipa_idview.ad-users.name
+  hosts      = ["legacy-1.example.lan"]
+  hostgroups = ["legacy-servers"]
+}
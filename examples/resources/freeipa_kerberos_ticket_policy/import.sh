@@ -0,0 +1,12 @@
+# The import id must be the login of the user the Kerberos ticket policy applies to,
+# or global for the global Kerberos ticket policy.
+
+import {
+  to = freeipa_kerberos_ticket_policy.global
+  id = "global"
+}
+
+import {
+  to = freeipa_kerberos_ticket_policy.operator
+  id = "operator"
+}
@@ -0,0 +1,19 @@
+resource "freeipa_kerberos_ticket_policy" "global" {
+  max_life      = 86400
+  max_renew     = 604800
+  otp_max_life  = 28800
+  otp_max_renew = 86400
+}
+
+resource "freeipa_user" "operator" {
+  name       = "operator"
+  first_name = "John"
+  last_name  = "Doe"
+  auth_type  = ["otp"]
+}
+
+resource "freeipa_kerberos_ticket_policy" "operator" {
+  user          = freeipa_user.operator.name
+  otp_max_life  = 3600
+  otp_max_renew = 7200
+}
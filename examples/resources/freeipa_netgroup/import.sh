@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the netgroup.
+
+import {
+  to = freeipa_netgroup.nfs-clients
+  id = "nfs-clients"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_netgroup" "nfs-clients" {
+  name           = "nfs-clients"
+  description    = "Hosts allowed to mount the legacy NFS exports"
+  nisdomain      = "example.lan"
+  external_hosts = ["nfs-client-1.legacy.lan"]
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <netgroup_name>/<type>/<identifier>
+# Use type "u", "g", "h", "hg" or "n" for a single user, user group, host, host group or nested netgroup membership, the identifier being the member name.
+# Use type "m" for multi-member membership, all the current members of the netgroup are imported.
+
+import {
+  to = freeipa_netgroup_membership.nfs-clients
+  id = "nfs-clients/m/nfs-clients"
+}
@@ -0,0 +1,11 @@
+resource "freeipa_netgroup_membership" "nfs-servers" {
+  name      = freeipa_netgroup.nfs-clients.name
+  hostgroup = "nfs-servers"
+}
+
+resource "freeipa_netgroup_membership" "nfs-clients" {
+  name       = freeipa_netgroup.nfs-clients.name
+  hosts      = ["web-1.example.lan", "web-2.example.lan"]
+  netgroups  = ["backup-clients"]
+  identifier = "nfs-clients"
+}
@@ -0,0 +1,6 @@
+# The import id must be "global", there is only one OTP configuration.
+
+import {
+  to = freeipa_otp_config.global
+  id = "global"
+}
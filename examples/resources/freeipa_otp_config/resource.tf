@@ -0,0 +1,6 @@
+resource "freeipa_otp_config" "global" {
+  totp_auth_window = 300
+  totp_sync_window = 43200
+  hotp_auth_window = 10
+  hotp_sync_window = 100
+}
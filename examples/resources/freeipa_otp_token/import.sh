@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the unique id of the OTP token.
+# The otpauth URI of an imported token cannot be retrieved, the uri attribute stays empty.
+
+import {
+  to = freeipa_otp_token.backup
+  id = "4b7b40c9-6c56-4a4b-8f3b-4d0f9d6a1c2e"
+}
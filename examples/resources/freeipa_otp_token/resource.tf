@@ -0,0 +1,21 @@
+resource "freeipa_user" "backup" {
+  name       = "svc-backup"
+  first_name = "Backup"
+  last_name  = "Service"
+  auth_type  = ["otp"]
+}
+
+resource "freeipa_otp_token" "backup" {
+  owner       = freeipa_user.backup.name
+  description = "TOTP token of the backup service account"
+  algorithm   = "sha256"
+  digits      = 6
+  interval    = 30
+  managers    = ["admin"]
+  not_after   = "2027-01-01T00:00:00Z"
+}
+
+output "backup_otp_uri" {
+  value     = freeipa_otp_token.backup.uri
+  sensitive = true
+}
@@ -0,0 +1,6 @@
+# The passkey configuration is global, the import id must be global.
+
+import {
+  to = freeipa_passkey_config.global
+  id = "global"
+}
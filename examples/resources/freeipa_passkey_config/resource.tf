@@ -0,0 +1,3 @@
+resource "freeipa_passkey_config" "global" {
+  require_user_verification = true
+}
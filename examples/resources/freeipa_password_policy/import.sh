@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the name of the user group the password policy applies to,
+# or global_policy for the global password policy.
+
+import {
+  to = freeipa_password_policy.admins_ext
+  id = "admins-ext"
+}
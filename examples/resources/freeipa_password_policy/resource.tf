@@ -0,0 +1,28 @@
+resource "freeipa_password_policy" "global" {
+  name             = "global_policy"
+  max_lifetime     = 90
+  min_length       = 12
+  min_classes      = 3
+  history_size     = 6
+  max_failures     = 6
+  failure_interval = 60
+  lockout_time     = 600
+}
+
+resource "freeipa_group" "admins_ext" {
+  name        = "admins-ext"
+  description = "External administrators"
+}
+
+resource "freeipa_password_policy" "admins_ext" {
+  name             = freeipa_group.admins_ext.name
+  priority         = 10
+  max_lifetime     = 30
+  min_length       = 16
+  min_classes      = 4
+  max_repeat       = 2
+  max_sequence     = 3
+  dictionary_check = true
+  user_check       = true
+  grace_limit      = 0
+}
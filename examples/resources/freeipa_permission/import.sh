@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the permission.
+
+import {
+  to = freeipa_permission.phone-numbers
+  id = "Write user phone numbers"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_permission" "phone-numbers" {
+  name       = "Write user phone numbers"
+  rights     = ["write"]
+  type       = "user"
+  attributes = ["telephonenumber", "mobile"]
+}
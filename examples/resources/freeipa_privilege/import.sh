@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the privilege.
+
+import {
+  to = freeipa_privilege.phone-admins
+  id = "Phone number administrators"
+}
@@ -0,0 +1,4 @@
+resource "freeipa_privilege" "phone-admins" {
+  name        = "Phone number administrators"
+  description = "Manage the phone numbers of the users"
+}
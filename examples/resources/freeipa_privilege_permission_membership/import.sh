@@ -0,0 +1,8 @@
+# The import id uses the format: <privilege_name>/<type>/<identifier>
+# Use type "p" for a single permission membership, the identifier being the permission name.
+# Use type "mp" for multi-permission membership, all the current permissions of the privilege are imported.
+
+import {
+  to = freeipa_privilege_permission_membership.phone-admins-read
+  id = "Phone number administrators/mp/phone-admins-read"
+}
@@ -0,0 +1,10 @@
+resource "freeipa_privilege_permission_membership" "phone-admins" {
+  name       = freeipa_privilege.phone-admins.name
+  permission = freeipa_permission.phone-numbers.name
+}
+
+resource "freeipa_privilege_permission_membership" "phone-admins-read" {
+  name        = freeipa_privilege.phone-admins.name
+  permissions = ["System: Read User Addressbook Attributes", "System: Read User Basic Attributes"]
+  identifier  = "phone-admins-read"
+}
@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the name of the RADIUS proxy server.
+# The secret cannot be read back from FreeIPA, it is set again on the next apply.
+
+import {
+  to = freeipa_radius_proxy.corp
+  id = "corp-radius"
+}
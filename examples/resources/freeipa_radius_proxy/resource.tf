@@ -0,0 +1,23 @@
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "freeipa_radius_proxy" "corp" {
+  name           = "corp-radius"
+  description    = "Corporate RADIUS server"
+  server         = "radius.example.lan:1812"
+  secret         = var.radius_secret
+  timeout        = 5
+  retries        = 3
+  user_attribute = "mail"
+}
+
+resource "freeipa_user" "remote" {
+  name                = "remote-user"
+  first_name          = "Remote"
+  last_name           = "User"
+  email_address       = ["remote-user@example.lan"]
+  auth_type           = ["radius"]
+  radius_proxy_config = freeipa_radius_proxy.corp.name
+}
@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the role.
+
+import {
+  to = freeipa_role.helpdesk
+  id = "helpdesk"
+}
@@ -0,0 +1,4 @@
+resource "freeipa_role" "helpdesk" {
+  name        = "helpdesk"
+  description = "Helpdesk operators"
+}
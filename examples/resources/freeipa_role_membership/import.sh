@@ -0,0 +1,8 @@
+# The import id uses the format: <role_name>/<type>/<identifier>
+# Use type "u", "g", "h", "hg" or "s" for a single user, user group, host, host group or service membership, the identifier being the member name.
+# Use type "m" for multi-member membership, all the current members of the role are imported.
+
+import {
+  to = freeipa_role_membership.helpdesk-members
+  id = "helpdesk/m/helpdesk-members"
+}
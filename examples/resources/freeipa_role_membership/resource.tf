@@ -0,0 +1,11 @@
+resource "freeipa_role_membership" "helpdesk-group" {
+  name  = freeipa_role.helpdesk.name
+  group = "helpdesk-operators"
+}
+
+resource "freeipa_role_membership" "helpdesk-members" {
+  name       = freeipa_role.helpdesk.name
+  users      = ["jdoe", "asmith"]
+  hostgroups = ["helpdesk-workstations"]
+  identifier = "helpdesk-members"
+}
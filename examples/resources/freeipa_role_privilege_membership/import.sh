@@ -0,0 +1,8 @@
+# The import id uses the format: <role_name>/<type>/<identifier>
+# Use type "p" for a single privilege membership, the identifier being the privilege name.
+# Use type "mp" for multi-privilege membership, all the current privileges of the role are imported.
+
+import {
+  to = freeipa_role_privilege_membership.helpdesk
+  id = "helpdesk/mp/helpdesk-privileges"
+}
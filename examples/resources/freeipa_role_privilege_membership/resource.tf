@@ -0,0 +1,5 @@
+resource "freeipa_role_privilege_membership" "helpdesk" {
+  name       = freeipa_role.helpdesk.name
+  privileges = [freeipa_privilege.phone-admins.name, "Modify Users and Reset passwords"]
+  identifier = "helpdesk-privileges"
+}
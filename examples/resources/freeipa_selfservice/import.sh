@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the self-service permission.
+
+import {
+  to = freeipa_selfservice.phone-numbers
+  id = "Users can manage their own phone numbers"
+}
@@ -0,0 +1,5 @@
+resource "freeipa_selfservice" "phone-numbers" {
+  name        = "Users can manage their own phone numbers"
+  permissions = ["write"]
+  attributes  = ["telephonenumber", "mobile"]
+}
@@ -0,0 +1,6 @@
+# The import id must be exactly the same as the name of the SELinux user map.
+
+import {
+  to = freeipa_selinux_usermap.staff
+  id = "staff"
+}
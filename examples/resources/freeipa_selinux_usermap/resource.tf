@@ -0,0 +1,11 @@
+resource "freeipa_selinux_usermap" "staff" {
+  name        = "staff"
+  selinuxuser = "staff_u:s0-s0:c0.c1023"
+  description = "Map administrators to the confined staff_u SELinux user"
+}
+
+resource "freeipa_selinux_usermap" "guests" {
+  name        = "guests"
+  selinuxuser = "guest_u:s0"
+  hbac_rule   = "allow_guests"
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <selinux_usermap_name>/<type>/<identifier>
+# Use type "h" or "hg" for a single host or host group membership, the identifier being the member name.
+# Use type "mh" for multi-member membership, the identifier being the identifier attribute of the resource.
+
+import {
+  to = freeipa_selinux_usermap_host_membership.servers
+  id = "staff/mh/servers"
+}
@@ -0,0 +1,6 @@
+resource "freeipa_selinux_usermap_host_membership" "servers" {
+  name       = freeipa_selinux_usermap.staff.name
+  hosts      = ["web-1.example.lan"]
+  hostgroups = ["webservers"]
+  identifier = "servers"
+}
@@ -0,0 +1,8 @@
+# The import id uses the format: <selinux_usermap_name>/<type>/<identifier>
+# Use type "u" or "g" for a single user or user group membership, the identifier being the member name.
+# Use type "mu" for multi-member membership, the identifier being the identifier attribute of the resource.
+
+import {
+  to = freeipa_selinux_usermap_user_membership.admins
+  id = "staff/mu/admins"
+}
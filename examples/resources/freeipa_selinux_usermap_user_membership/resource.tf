@@ -0,0 +1,6 @@
+resource "freeipa_selinux_usermap_user_membership" "admins" {
+  name       = freeipa_selinux_usermap.staff.name
+  users      = ["jdoe"]
+  groups     = ["admins"]
+  identifier = "admins"
+}
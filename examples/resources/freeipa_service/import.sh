@@ -0,0 +1,10 @@
+# The import id is the principal of the service, written as in the configuration (with or without its realm).
+
+import {
+  to = freeipa_service.http
+  id = "HTTP/web.example.test"
+}
+
+resource "freeipa_service" "http" {
+  name = "HTTP/web.example.test"
+}
\ No newline at end of file
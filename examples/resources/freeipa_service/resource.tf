@@ -0,0 +1,12 @@
+resource "freeipa_host" "web" {
+  name       = "web.example.test"
+  ip_address = "192.168.1.80"
+}
+
+resource "freeipa_service" "http" {
+  name                              = "HTTP/${freeipa_host.web.name}"
+  krb_auth_indicators               = ["otp"]
+  pac_type                          = ["MS-PAC"]
+  managed_by_hosts                  = ["proxy.example.test"]
+  allowed_to_retrieve_keytab_groups = ["web-admins"]
+}
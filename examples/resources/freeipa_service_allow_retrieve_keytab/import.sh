@@ -0,0 +1,13 @@
+# The import id uses the format: <service_principal>/<type>/<member>
+# Use type "u" for a user, "g" for a group, "h" for a host and "hg" for a hostgroup.
+# The slashes of the service principal are encoded as %2F.
+
+import {
+  to = freeipa_service_allow_retrieve_keytab.admins
+  id = "HTTP%2Fweb.example.test/g/admins"
+}
+
+resource "freeipa_service_allow_retrieve_keytab" "admins" {
+  name  = "HTTP/web.example.test"
+  group = "admins"
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "freeipa_service_allow_retrieve_keytab" "admins" {
+  name  = "HTTP/web.example.test"
+  group = "admins"
+}
+
+resource "freeipa_service_allow_retrieve_keytab" "automation" {
+  name       = "HTTP/web.example.test"
+  users      = ["automation"]
+  hostgroups = ["deploy-servers"]
+  identifier = "automation"
+}
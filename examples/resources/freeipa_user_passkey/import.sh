@@ -0,0 +1,7 @@
+# The import id must be exactly the same as the login of the user.
+# All the passkey mappings of the user are managed by the imported resource.
+
+import {
+  to = freeipa_user_passkey.jdoe
+  id = "jdoe"
+}
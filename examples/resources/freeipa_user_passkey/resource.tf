@@ -0,0 +1,13 @@
+resource "freeipa_user" "jdoe" {
+  name       = "jdoe"
+  first_name = "John"
+  last_name  = "Doe"
+  auth_type  = ["passkey"]
+}
+
+resource "freeipa_user_passkey" "jdoe" {
+  user = freeipa_user.jdoe.name
+  passkeys = [
+    "passkey:5Ydrqxoi2s0bRhKyFi6lN8hHmmydqJ+N1lVhoY+iwlE+C85oe1FWgbeZtc6/fHJF4gsXhXxDzNj+UAgC5zJyZg==,MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEiSXtkIgRdS0iAWjD19w4w+sH1oQEJXdSQ/9Yw2pbj2q17R8qUrb2RAUsbidT6F+JbMFYlwvFh8K3SrLS/4ULpA==",
+  ]
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomountKeyResource{}
+var _ resource.ResourceWithImportState = &AutomountKeyResource{}
+
+func NewAutomountKeyResource() resource.Resource {
+	return &AutomountKeyResource{}
+}
+
+// AutomountKeyResource defines the resource implementation.
+type AutomountKeyResource struct {
+	client *ipa.Client
+}
+
+// AutomountKeyResourceModel describes the resource data model.
+type AutomountKeyResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Map         types.String `tfsdk:"map"`
+	Key         types.String `tfsdk:"key"`
+	Information types.String `tfsdk:"information"`
+}
+
+func (r *AutomountKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automount_key"
+}
+
+func (r *AutomountKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA automount key resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Automount location of the map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"map": schema.StringAttribute{
+				MarkdownDescription: "Automount map the key belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Automount key, the mount point relative to the indirect map or the absolute path for a direct map (ex: `*`, `/share`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"information": schema.StringAttribute{
+				MarkdownDescription: "Mount information, the mount options and location of the filesystem (ex: `-fstype=nfs4,rw nfs.example.lan:/export/home/&`)",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *AutomountKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomountKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.AutomountkeyAddArgs{
+		Automountlocationcn:          data.Location.ValueString(),
+		Automountmapautomountmapname: data.Map.ValueString(),
+		Automountkey:                 data.Key.ValueString(),
+		Automountinformation:         data.Information.ValueString(),
+	}
+	_, err := r.client.AutomountkeyAdd(&args, &ipa.AutomountkeyAddOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa automount key: %s", err))
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Location.ValueString(), data.Map.ValueString(), data.Key.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.AutomountkeyShowArgs{
+		Automountlocationcn:          data.Location.ValueString(),
+		Automountmapautomountmapname: data.Map.ValueString(),
+		Automountkey:                 data.Key.ValueString(),
+	}
+	optArgs := ipa.AutomountkeyShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.AutomountkeyShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Automount key not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount key: %s", err))
+			return
+		}
+	}
+
+	data.Information = types.StringValue(res.Result.Automountinformation)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutomountKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Information.Equal(state.Information) {
+		args := ipa.AutomountkeyModArgs{
+			Automountlocationcn:          data.Location.ValueString(),
+			Automountmapautomountmapname: data.Map.ValueString(),
+			Automountkey:                 data.Key.ValueString(),
+		}
+		optArgs := ipa.AutomountkeyModOptionalArgs{
+			Automountinformation:    state.Information.ValueStringPointer(),
+			Newautomountinformation: data.Information.ValueStringPointer(),
+		}
+		_, err := r.client.AutomountkeyMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa automount key: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomountKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.AutomountkeyDelArgs{
+		Automountlocationcn:          data.Location.ValueString(),
+		Automountmapautomountmapname: data.Map.ValueString(),
+		Automountkey:                 data.Key.ValueString(),
+	}
+	_, err := r.client.AutomountkeyDel(&args, &ipa.AutomountkeyDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa automount key: %s", err))
+		return
+	}
+}
+
+func (r *AutomountKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	location, mapName, key, err := parseAutomountKeyID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), location)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("map"), mapName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// The key of a direct map is an absolute path, it is kept as the last part of the ID.
+func parseAutomountKeyID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine automount key ID %s", id)
+	}
+
+	return idParts[0], idParts[1], idParts[2], nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAAutomountKey_full(t *testing.T) {
+	testLocation := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-location\"",
+	}
+	testKey := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"map":         "\"auto.direct\"",
+		"key":         "\"/share\"",
+		"information": "\"-fstype=nfs4,ro nfs.testacc.ipatest.lan:/export/share\"",
+	}
+	testKeyModified := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"map":         "\"auto.direct\"",
+		"key":         "\"/share\"",
+		"information": "\"-fstype=nfs4,rw nfs.testacc.ipatest.lan:/export/share\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountKey_resource(testKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "id", "testacc-location/auto.direct//share"),
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "key", "/share"),
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "information", "-fstype=nfs4,ro nfs.testacc.ipatest.lan:/export/share"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountKey_resource(testKeyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_key.automount-key-0", "information", "-fstype=nfs4,rw nfs.testacc.ipatest.lan:/export/share"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountKey_resource(testKeyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
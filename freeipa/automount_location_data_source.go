@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AutomountLocationDataSource{}
+var _ datasource.DataSourceWithConfigure = &AutomountLocationDataSource{}
+
+func NewAutomountLocationDataSource() datasource.DataSource {
+	return &AutomountLocationDataSource{}
+}
+
+// AutomountLocationDataSource defines the data source implementation.
+type AutomountLocationDataSource struct {
+	client *ipa.Client
+}
+
+// AutomountLocationDataSourceModel describes the data source data model.
+type AutomountLocationDataSourceModel struct {
+	Id   types.String                  `tfsdk:"id"`
+	Name types.String                  `tfsdk:"name"`
+	Maps []AutomountMapDataSourceModel `tfsdk:"maps"`
+}
+
+// AutomountMapDataSourceModel describes an automount map of the location.
+type AutomountMapDataSourceModel struct {
+	Name        types.String                  `tfsdk:"name"`
+	Description types.String                  `tfsdk:"description"`
+	Keys        []AutomountKeyDataSourceModel `tfsdk:"keys"`
+}
+
+// AutomountKeyDataSourceModel describes an automount key of a map.
+type AutomountKeyDataSourceModel struct {
+	Key         types.String `tfsdk:"key"`
+	Information types.String `tfsdk:"information"`
+}
+
+func (r *AutomountLocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automount_location"
+}
+
+func (r *AutomountLocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA automount location data source.\nThe data source returns all the maps of the location with their keys.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the automount location",
+				Required:            true,
+			},
+			"maps": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the automount maps of the location",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the automount map",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the automount map",
+							Computed:            true,
+						},
+						"keys": schema.ListNestedAttribute{
+							MarkdownDescription: "List of the keys of the automount map",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "Automount key",
+										Computed:            true,
+									},
+									"information": schema.StringAttribute{
+										MarkdownDescription: "Mount information of the key",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AutomountLocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomountLocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AutomountLocationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.AutomountlocationShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.AutomountlocationShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.AutomountlocationShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa automount location %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount location %s", data.Name.ValueString()))
+		return
+	}
+
+	maps, err := findAutomountMaps(r.client, res.Result.Cn)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount maps of location %s: %s", res.Result.Cn, err))
+		return
+	}
+
+	data.Maps = []AutomountMapDataSourceModel{}
+	for _, m := range maps {
+		automountMap := AutomountMapDataSourceModel{
+			Name:        types.StringValue(m.Automountmapname),
+			Description: types.StringPointerValue(m.Description),
+			Keys:        []AutomountKeyDataSourceModel{},
+		}
+		keys, err := findAutomountKeys(r.client, res.Result.Cn, m.Automountmapname)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount keys of map %s: %s", m.Automountmapname, err))
+			return
+		}
+		for _, k := range keys {
+			automountMap.Keys = append(automountMap.Keys, AutomountKeyDataSourceModel{
+				Key:         types.StringValue(k.Automountkey),
+				Information: types.StringValue(k.Automountinformation),
+			})
+		}
+		data.Maps = append(data.Maps, automountMap)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
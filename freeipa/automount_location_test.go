@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAAutomountLocation_full(t *testing.T) {
+	testLocation := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-location\"",
+	}
+	testMap := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"name":        "\"auto.home\"",
+		"description": "\"Home directories\"",
+		"mount":       "\"/home\"",
+	}
+	testKey := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"map":         "freeipa_automount_map.automount-map-0.name",
+		"key":         "\"*\"",
+		"information": "\"-fstype=nfs4,rw nfs.testacc.ipatest.lan:/export/home/&\"",
+	}
+	testLocationDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_automount_location.automount-location-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_location.automount-location-0", "name", "testacc-location"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testMap) + testAccFreeIPAAutomountKey_resource(testKey) + testAccFreeIPAAutomountLocation_datasource(testLocationDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_automount_location.automount-location-0", "name", "testacc-location"),
+					resource.TestCheckResourceAttr("data.freeipa_automount_location.automount-location-0", "maps.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.freeipa_automount_location.automount-location-0", "maps.*", map[string]string{
+						"name":        "auto.home",
+						"description": "Home directories",
+						"keys.#":      "1",
+						"keys.0.key":  "*",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.freeipa_automount_location.automount-location-0", "maps.*", map[string]string{
+						"name":   "auto.master",
+						"keys.#": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testMap) + testAccFreeIPAAutomountKey_resource(testKey) + testAccFreeIPAAutomountLocation_datasource(testLocationDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,374 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutomountMapResource{}
+var _ resource.ResourceWithImportState = &AutomountMapResource{}
+
+func NewAutomountMapResource() resource.Resource {
+	return &AutomountMapResource{}
+}
+
+// AutomountMapResource defines the resource implementation.
+type AutomountMapResource struct {
+	client *ipa.Client
+}
+
+// AutomountMapResourceModel describes the resource data model.
+type AutomountMapResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Location    types.String `tfsdk:"location"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Mount       types.String `tfsdk:"mount"`
+	ParentMap   types.String `tfsdk:"parent_map"`
+}
+
+func (r *AutomountMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automount_map"
+}
+
+func (r *AutomountMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA automount map resource.\nSetting `mount` creates an indirect map, FreeIPA then adds the mount point key to the parent map.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Automount location of the map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the automount map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Automount map description",
+				Optional:            true,
+			},
+			"mount": schema.StringAttribute{
+				MarkdownDescription: "Mount point of an indirect map (ex: `/home`)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_map": schema.StringAttribute{
+				MarkdownDescription: "Map the mount point of an indirect map is added to. Defaults to `auto.master` for indirect maps.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("mount")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AutomountMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutomountMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Mount.IsNull() {
+		optArgs := ipa.AutomountmapAddIndirectOptionalArgs{}
+		args := ipa.AutomountmapAddIndirectArgs{
+			Automountlocationcn: data.Location.ValueString(),
+			Automountmapname:    data.Name.ValueString(),
+			Key:                 data.Mount.ValueString(),
+		}
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		if data.ParentMap.IsNull() || data.ParentMap.IsUnknown() {
+			data.ParentMap = types.StringValue("auto.master")
+		}
+		optArgs.Parentmap = data.ParentMap.ValueStringPointer()
+		_, err := r.client.AutomountmapAddIndirect(&args, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa automount indirect map: %s", err))
+			return
+		}
+	} else {
+		optArgs := ipa.AutomountmapAddOptionalArgs{}
+		args := ipa.AutomountmapAddArgs{
+			Automountlocationcn: data.Location.ValueString(),
+			Automountmapname:    data.Name.ValueString(),
+		}
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		data.ParentMap = types.StringNull()
+		_, err := r.client.AutomountmapAdd(&args, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa automount map: %s", err))
+			return
+		}
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", data.Location.ValueString(), data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.AutomountmapShowArgs{
+		Automountlocationcn: data.Location.ValueString(),
+		Automountmapname:    data.Name.ValueString(),
+	}
+	optArgs := ipa.AutomountmapShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.AutomountmapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Automount map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa automount map: %s", err))
+			return
+		}
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutomountMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.AutomountmapModArgs{
+		Automountlocationcn: data.Location.ValueString(),
+		Automountmapname:    data.Name.ValueString(),
+	}
+	optArgs := ipa.AutomountmapModOptionalArgs{}
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+		_, err := r.client.AutomountmapMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa automount map: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutomountMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutomountMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// FreeIPA also removes the mount point key of an indirect map from its parent map.
+	args := ipa.AutomountmapDelArgs{
+		Automountlocationcn: data.Location.ValueString(),
+		Automountmapname:    []string{data.Name.ValueString()},
+	}
+	_, err := r.client.AutomountmapDel(&args, &ipa.AutomountmapDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa automount map: %s", err))
+		return
+	}
+}
+
+func (r *AutomountMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	location, mapName, err := parseAutomountMapID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	args := ipa.AutomountmapShowArgs{
+		Automountlocationcn: location,
+		Automountmapname:    mapName,
+	}
+	res, err := r.client.AutomountmapShow(&args, &ipa.AutomountmapShowOptionalArgs{All: &all})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Automount map not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa automount map: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("location"), location)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), mapName)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+
+	// An indirect map is referenced by a key of its parent map whose mount information is the map name.
+	mount, parentMap, err := findAutomountMapParent(r.client, location, mapName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa automount keys: %s", err))
+		return
+	}
+	if mount != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mount"), mount)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_map"), parentMap)...)
+	}
+}
+
+func findAutomountMapParent(client *ipa.Client, location string, mapName string) (string, string, error) {
+	maps, err := findAutomountMaps(client, location)
+	if err != nil {
+		return "", "", err
+	}
+	for _, m := range maps {
+		if m.Automountmapname == mapName {
+			continue
+		}
+		keys, err := findAutomountKeys(client, location, m.Automountmapname)
+		if err != nil {
+			return "", "", err
+		}
+		for _, k := range keys {
+			if k.Automountinformation == mapName {
+				return k.Automountkey, m.Automountmapname, nil
+			}
+		}
+	}
+	return "", "", nil
+}
+
+// findAutomountMaps returns all the maps of an automount location, without size limit.
+func findAutomountMaps(client *ipa.Client, location string) ([]ipa.Automountmap, error) {
+	sizelimit := 0
+	maps, err := client.AutomountmapFind("", &ipa.AutomountmapFindArgs{Automountlocationcn: location}, &ipa.AutomountmapFindOptionalArgs{Sizelimit: &sizelimit})
+	if err != nil {
+		return nil, err
+	}
+	if maps.Truncated {
+		return nil, fmt.Errorf("the automount maps of location %s were truncated by the server search limits", location)
+	}
+	return maps.Result, nil
+}
+
+// findAutomountKeys returns all the keys of an automount map, without size limit.
+func findAutomountKeys(client *ipa.Client, location string, mapName string) ([]ipa.Automountkey, error) {
+	sizelimit := 0
+	keyArgs := ipa.AutomountkeyFindArgs{
+		Automountlocationcn:          location,
+		Automountmapautomountmapname: mapName,
+	}
+	keys, err := client.AutomountkeyFind("", &keyArgs, &ipa.AutomountkeyFindOptionalArgs{Sizelimit: &sizelimit})
+	if err != nil {
+		return nil, err
+	}
+	if keys.Truncated {
+		return nil, fmt.Errorf("the automount keys of map %s were truncated by the server search limits", mapName)
+	}
+	return keys.Result, nil
+}
+
+func parseAutomountMapID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("unable to determine automount map ID %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
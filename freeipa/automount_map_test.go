@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAAutomountMap_direct(t *testing.T) {
+	testLocation := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-location\"",
+	}
+	testMap := map[string]string{
+		"index":    "0",
+		"location": "freeipa_automount_location.automount-location-0.name",
+		"name":     "\"auto.share\"",
+	}
+	testMapModified := map[string]string{
+		"index":       "0",
+		"location":    "freeipa_automount_location.automount-location-0.name",
+		"name":        "\"auto.share\"",
+		"description": "\"Shared directories\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testMap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "location", "testacc-location"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "name", "auto.share"),
+					resource.TestCheckNoResourceAttr("freeipa_automount_map.automount-map-0", "parent_map"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testMapModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "description", "Shared directories"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testMapModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAAutomountMap_indirect(t *testing.T) {
+	testLocation := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-location\"",
+	}
+	testParentMap := map[string]string{
+		"index":    "1",
+		"location": "freeipa_automount_location.automount-location-0.name",
+		"name":     "\"auto.data\"",
+		"mount":    "\"/data\"",
+	}
+	testMap := map[string]string{
+		"index":      "0",
+		"location":   "freeipa_automount_location.automount-location-0.name",
+		"name":       "\"auto.projects\"",
+		"mount":      "\"projects\"",
+		"parent_map": "freeipa_automount_map.automount-map-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testParentMap) + testAccFreeIPAAutomountMap_resource(testMap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-1", "mount", "/data"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-1", "parent_map", "auto.master"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "mount", "projects"),
+					resource.TestCheckResourceAttr("freeipa_automount_map.automount-map-0", "parent_map", "auto.data"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAAutomountLocation_resource(testLocation) + testAccFreeIPAAutomountMap_resource(testParentMap) + testAccFreeIPAAutomountMap_resource(testMap),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
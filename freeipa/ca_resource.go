@@ -0,0 +1,388 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaResource{}
+var _ resource.ResourceWithImportState = &CaResource{}
+
+func NewCaResource() resource.Resource {
+	return &CaResource{}
+}
+
+// CaResource defines the resource implementation.
+type CaResource struct {
+	client *ipa.Client
+}
+
+// CaResourceModel describes the resource data model.
+type CaResourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Subject     distinguishedNameValue `tfsdk:"subject"`
+	Issuer      types.String           `tfsdk:"issuer"`
+	AuthorityId types.String           `tfsdk:"authority_id"`
+}
+
+func (r *CaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ca"
+}
+
+func (r *CaResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *CaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA lightweight sub-CA resource.\nThe sub-CA is disabled before its deletion.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the certificate authority",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Certificate authority description",
+				Optional:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject distinguished name of the certificate authority (e.g. `CN=VPN CA,O=EXAMPLE.LAN`)",
+				CustomType:          distinguishedNameType{},
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(caSubjectRequiresReplace, "Replaces the certificate authority unless the subject is the same DN.", "Replaces the certificate authority unless the subject is the same DN."),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer distinguished name of the certificate authority",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"authority_id": schema.StringAttribute{
+				MarkdownDescription: "Dogtag authority ID of the certificate authority",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaAddOptionalArgs{}
+
+	args := ipa.CaAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipacasubjectdn: data.Subject.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+
+	res, err := r.client.CaAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certificate authority: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Created freeipa certificate authority %s", res.Result.String()))
+
+	data.Id = data.Name
+	data.Subject = distinguishedNameValue{StringValue: types.StringValue(res.Result.Ipacasubjectdn)}
+	data.Issuer = types.StringValue(res.Result.Ipacaissuerdn)
+	data.AuthorityId = types.StringValue(res.Result.Ipacaid)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.CaShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.CaShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.CaShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certificate authority not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate authority: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa certificate authority %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate authority %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	data.Subject = distinguishedNameValue{StringValue: types.StringValue(res.Result.Ipacasubjectdn)}
+	data.Issuer = types.StringValue(res.Result.Ipacaissuerdn)
+	data.AuthorityId = types.StringValue(res.Result.Ipacaid)
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaModOptionalArgs{}
+
+	args := ipa.CaModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+	}
+	_, err := r.client.CaMod(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa certificate authority: %s", err))
+			return
+		}
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A certificate authority must be disabled before it can be deleted.
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Disable freeipa certificate authority %s", data.Name.ValueString()))
+	_, err := r.client.CaDisable(&ipa.CaDisableArgs{Cn: data.Name.ValueString()}, &ipa.CaDisableOptionalArgs{})
+	if err != nil && !strings.Contains(err.Error(), "already disabled") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa certificate authority: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa certificate authority %s", data.Name.ValueString()))
+	args := ipa.CaDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err = r.client.CaDel(&args, &ipa.CaDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Certificate authority %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *CaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// caSubjectRequiresReplace only replaces the certificate authority when its subject is another DN.
+// FreeIPA normalizes the spacing and the case of the DN, which must not force a replacement.
+func caSubjectRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = normalizeDN(req.StateValue.ValueString()) != normalizeDN(req.PlanValue.ValueString())
+}
+
+// distinguishedNameType is a string type whose values are equal when they are the same DN,
+// regardless of their spacing and case.
+type distinguishedNameType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = distinguishedNameType{}
+
+func (t distinguishedNameType) String() string {
+	return "distinguishedNameType"
+}
+
+func (t distinguishedNameType) Equal(o attr.Type) bool {
+	other, ok := o.(distinguishedNameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t distinguishedNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return distinguishedNameValue{StringValue: in}, nil
+}
+
+func (t distinguishedNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+	return distinguishedNameValue{StringValue: stringValue}, nil
+}
+
+func (t distinguishedNameType) ValueType(ctx context.Context) attr.Value {
+	return distinguishedNameValue{}
+}
+
+// distinguishedNameValue is a DN, the value read from FreeIPA does not replace the same DN in the state.
+type distinguishedNameValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = distinguishedNameValue{}
+
+func (v distinguishedNameValue) Type(ctx context.Context) attr.Type {
+	return distinguishedNameType{}
+}
+
+func (v distinguishedNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(distinguishedNameValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v distinguishedNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(distinguishedNameValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable))
+		return false, diags
+	}
+	return normalizeDN(v.ValueString()) == normalizeDN(newValue.ValueString()), diags
+}
+
+// normalizeDN returns a distinguished name without the spaces around its separators and in lower case.
+func normalizeDN(dn string) string {
+	var rdns []string
+	var rdn strings.Builder
+	escaped := false
+	for _, c := range dn {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == ',':
+			rdns = append(rdns, normalizeRDN(rdn.String()))
+			rdn.Reset()
+			continue
+		}
+		rdn.WriteRune(c)
+	}
+	rdns = append(rdns, normalizeRDN(rdn.String()))
+	return strings.Join(rdns, ",")
+}
+
+func normalizeRDN(rdn string) string {
+	attr, value, found := strings.Cut(rdn, "=")
+	if !found {
+		return strings.ToLower(strings.TrimSpace(rdn))
+	}
+	return strings.ToLower(strings.TrimSpace(attr)) + "=" + strings.ToLower(strings.TrimSpace(value))
+}
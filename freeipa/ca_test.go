@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACa_full(t *testing.T) {
+	testCa := map[string]string{
+		"index":   "0",
+		"name":    "\"testacc-ca\"",
+		"subject": "\"CN=Testacc CA,O=IPATEST.LAN\"",
+	}
+	testCaSpacing := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-ca\"",
+		"subject":     "\"CN=Testacc CA, o=IPATEST.LAN\"",
+		"description": "\"A sub-CA for acceptance tests\"",
+	}
+	testCaModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-ca\"",
+		"subject":     "\"CN=Testacc CA,O=IPATEST.LAN\"",
+		"description": "\"A sub-CA for acceptance tests\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCa),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "name", "testacc-ca"),
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "subject", "CN=Testacc CA,O=IPATEST.LAN"),
+					resource.TestCheckResourceAttrSet("freeipa_ca.ca-0", "issuer"),
+					resource.TestCheckResourceAttrSet("freeipa_ca.ca-0", "authority_id"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "description", "A sub-CA for acceptance tests"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// The same DN with another spacing or case must not replace the sub-CA.
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaSpacing),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_ca.ca-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_ca.ca-0", "subject", "CN=Testacc CA, o=IPATEST.LAN"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACa_resource(testCaSpacing),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclCaMembershipResource{}
+var _ resource.ResourceWithImportState = &CaaclCaMembershipResource{}
+
+func NewCaaclCaMembershipResource() resource.Resource {
+	return &CaaclCaMembershipResource{}
+}
+
+// CaaclCaMembershipResource defines the resource implementation.
+type CaaclCaMembershipResource struct {
+	client *ipa.Client
+}
+
+// CaaclCaMembershipResourceModel describes the resource data model.
+type CaaclCaMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Ca         types.String `tfsdk:"ca"`
+	Cas        types.List   `tfsdk:"cas"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *CaaclCaMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl_ca_membership"
+}
+
+func (r *CaaclCaMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("ca"),
+			path.MatchRoot("cas"),
+		),
+	}
+}
+
+func (r *CaaclCaMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL certificate authority membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "Certificate authority to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cas": schema.ListAttribute{
+				MarkdownDescription: "List of certificate authorities to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple CA ACL certificate authority membership resources on the same CA ACL. Mandatory for using cas configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaaclCaMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclCaMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclCaMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddCaOptionalArgs{}
+
+	args := ipa.CaaclAddCaArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Ca.IsNull() {
+		v := []string{data.Ca.ValueString()}
+		optArgs.Ca = &v
+		id = fmt.Sprintf("%s/c/%s", encodeSlash(data.Name.ValueString()), data.Ca.ValueString())
+	}
+	if !data.Cas.IsNull() {
+		v := listStringValues(data.Cas)
+		optArgs.Ca = &v
+		id = fmt.Sprintf("%s/mc/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.CaaclAddCa(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL certificate authority membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL certificate authority membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclCaMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclCaMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caaclId, typeId, memberId, err := parseCaaclCaMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_ca_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "c":
+		if res.Result.IpamembercaCa == nil || !isStringListContainsCaseInsensistive(res.Result.IpamembercaCa, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL certificate authority membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mc":
+		if !data.Cas.IsNull() {
+			var diag diag.Diagnostics
+			data.Cas, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Cas, res.Result.IpamembercaCa))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclCaMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclCaMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	addedCas, deletedCas := diffStringLists(state.Cas, data.Cas)
+
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if len(addedCas) > 0 {
+		args := ipa.CaaclAddCaArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclAddCa(&args, &ipa.CaaclAddCaOptionalArgs{Ca: &addedCas})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL certificate authority membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL certificate authority membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL certificate authority membership: %v", _v.Failed))
+		}
+	}
+	if len(deletedCas) > 0 {
+		args := ipa.CaaclRemoveCaArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclRemoveCa(&args, &ipa.CaaclRemoveCaOptionalArgs{Ca: &deletedCas})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL certificate authority membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL certificate authority membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL certificate authority membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclCaMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclCaMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	caaclId, typeId, memberId, err := parseCaaclCaMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_ca_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.CaaclRemoveCaOptionalArgs{}
+
+	args := ipa.CaaclRemoveCaArgs{
+		Cn: caaclId,
+	}
+
+	switch typeId {
+	case "c":
+		v := []string{memberId}
+		optArgs.Ca = &v
+	case "mc":
+		v := listStringValues(data.Cas)
+		optArgs.Ca = &v
+	}
+
+	_, err = r.client.CaaclRemoveCa(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa CA ACL certificate authority membership: %s", err))
+		return
+	}
+}
+
+func (r *CaaclCaMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	caaclId, typeId, memberId, err := parseCaaclCaMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "CA ACL not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), caaclId)...)
+
+	switch typeId {
+	case "c":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ca"), memberId)...)
+	case "mc":
+		// The imported membership manages all the certificate authorities currently member of the CA ACL.
+		var cas []string
+		if res.Result.IpamembercaCa != nil {
+			cas = *res.Result.IpamembercaCa
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cas"), cas)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseCaaclCaMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine CA ACL certificate authority membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	ca := idParts[2]
+
+	return name, _type, ca, nil
+}
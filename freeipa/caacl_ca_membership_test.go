@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaaclCaMembership_simple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testCa := map[string]string{
+		"index":   "0",
+		"name":    "\"testacc-ca\"",
+		"subject": "\"CN=Testacc CA,O=IPATEST.LAN\"",
+	}
+	testCaMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_caacl.caacl-0.name",
+		"ca":    "\"ipa\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACa_resource(testCa) + testAccFreeIPACaaclCaMembership_resource(testCaMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_ca_membership.caacl-ca-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_ca_membership.caacl-ca-membership-0", "ca", "ipa"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACa_resource(testCa) + testAccFreeIPACaaclCaMembership_resource(testCaMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaaclCaMembership_multiple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testCa := map[string]string{
+		"index":   "0",
+		"name":    "\"testacc-ca\"",
+		"subject": "\"CN=Testacc CA,O=IPATEST.LAN\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"cas":        "[\"ipa\", freeipa_ca.ca-0.name]",
+		"identifier": "\"cas-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACa_resource(testCa) + testAccFreeIPACaaclCaMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_ca_membership.caacl-ca-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_ca_membership.caacl-ca-membership-0", "cas.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACa_resource(testCa) + testAccFreeIPACaaclCaMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,464 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclHostMembershipResource{}
+var _ resource.ResourceWithImportState = &CaaclHostMembershipResource{}
+
+func NewCaaclHostMembershipResource() resource.Resource {
+	return &CaaclHostMembershipResource{}
+}
+
+// CaaclHostMembershipResource defines the resource implementation.
+type CaaclHostMembershipResource struct {
+	client *ipa.Client
+}
+
+// CaaclHostMembershipResourceModel describes the resource data model.
+type CaaclHostMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Host       types.String `tfsdk:"host"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	Hostgroup  types.String `tfsdk:"hostgroup"`
+	Hostgroups types.List   `tfsdk:"hostgroups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *CaaclHostMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl_host_membership"
+}
+
+func (r *CaaclHostMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hostgroups"),
+		),
+	}
+}
+
+func (r *CaaclHostMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL host membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Host group to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple CA ACL host membership resources on the same CA ACL. Mandatory for using hosts/hostgroups configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaaclHostMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclHostMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclHostMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddHostOptionalArgs{}
+
+	args := ipa.CaaclAddHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+		id = fmt.Sprintf("%s/h/%s", encodeSlash(data.Name.ValueString()), data.Host.ValueString())
+	}
+	if !data.Hostgroup.IsNull() {
+		v := []string{data.Hostgroup.ValueString()}
+		optArgs.Hostgroup = &v
+		id = fmt.Sprintf("%s/hg/%s", encodeSlash(data.Name.ValueString()), data.Hostgroup.ValueString())
+	}
+	if !data.Hosts.IsNull() || !data.Hostgroups.IsNull() {
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+		id = fmt.Sprintf("%s/mh/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.CaaclAddHost(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL host membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL host membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclHostMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclHostMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caaclId, typeId, memberId, err := parseCaaclHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_host_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "h":
+		if res.Result.MemberhostHost == nil || !isStringListContainsCaseInsensistive(res.Result.MemberhostHost, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL host membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "hg":
+		if res.Result.MemberhostHostgroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberhostHostgroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL host group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mh":
+		if !data.Hosts.IsNull() {
+			var diag diag.Diagnostics
+			data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hosts, res.Result.MemberhostHost))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hostgroups.IsNull() {
+			var diag diag.Diagnostics
+			data.Hostgroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hostgroups, res.Result.MemberhostHostgroup))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclHostMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclHostMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.CaaclAddHostOptionalArgs{}
+
+	memberAddArgs := ipa.CaaclAddHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.CaaclRemoveHostOptionalArgs{}
+
+	memberDelArgs := ipa.CaaclRemoveHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Hosts.Equal(state.Hosts) {
+		addedHosts, deletedHosts := diffStringLists(state.Hosts, data.Hosts)
+		if len(addedHosts) > 0 {
+			memberAddOptArgs.Host = &addedHosts
+			hasMemberAdd = true
+		}
+		if len(deletedHosts) > 0 {
+			memberDelOptArgs.Host = &deletedHosts
+			hasMemberDel = true
+		}
+	}
+	if !data.Hostgroups.Equal(state.Hostgroups) {
+		addedHostgroups, deletedHostgroups := diffStringLists(state.Hostgroups, data.Hostgroups)
+		if len(addedHostgroups) > 0 {
+			memberAddOptArgs.Hostgroup = &addedHostgroups
+			hasMemberAdd = true
+		}
+		if len(deletedHostgroups) > 0 {
+			memberDelOptArgs.Hostgroup = &deletedHostgroups
+			hasMemberDel = true
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.CaaclAddHost(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL host membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL host membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL host membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.CaaclRemoveHost(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL host membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL host membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL host membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclHostMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclHostMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	caaclId, typeId, memberId, err := parseCaaclHostMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_host_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.CaaclRemoveHostOptionalArgs{}
+
+	args := ipa.CaaclRemoveHostArgs{
+		Cn: caaclId,
+	}
+
+	switch typeId {
+	case "h":
+		v := []string{memberId}
+		optArgs.Host = &v
+	case "hg":
+		v := []string{memberId}
+		optArgs.Hostgroup = &v
+	case "mh":
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+	}
+
+	_, err = r.client.CaaclRemoveHost(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa CA ACL host membership: %s", err))
+		return
+	}
+}
+
+func (r *CaaclHostMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	caaclId, typeId, memberId, err := parseCaaclHostMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "CA ACL not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), caaclId)...)
+
+	switch typeId {
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "mh":
+		// The imported membership manages all the hosts and host groups currently member of the CA ACL.
+		var hosts, hostgroups []string
+		if res.Result.MemberhostHost != nil {
+			hosts = *res.Result.MemberhostHost
+		}
+		if res.Result.MemberhostHostgroup != nil {
+			hostgroups = *res.Result.MemberhostHostgroup
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), hosts)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroups"), hostgroups)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseCaaclHostMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine CA ACL host membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	host := idParts[2]
+
+	return name, _type, host, nil
+}
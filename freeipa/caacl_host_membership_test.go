@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaaclHostMembership_simple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testHostMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_caacl.caacl-0.name",
+		"host":  "freeipa_host.host-0.name",
+	}
+	testHostgroupMembership := map[string]string{
+		"index":     "1",
+		"name":      "freeipa_caacl.caacl-0.name",
+		"hostgroup": "freeipa_hostgroup.hostgroup-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPACaaclHostMembership_resource(testHostMembership) + testAccFreeIPACaaclHostMembership_resource(testHostgroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "host", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-1", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-1", "hostgroup", "testacc-hostgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPACaaclHostMembership_resource(testHostMembership) + testAccFreeIPACaaclHostMembership_resource(testHostgroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaaclHostMembership_multiple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"hosts-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPACaaclHostMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "hostgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_host_membership.caacl-host-membership-0", "hostgroups.0", "testacc-hostgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPACaaclHostMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
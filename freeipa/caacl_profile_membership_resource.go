@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclProfileMembershipResource{}
+var _ resource.ResourceWithImportState = &CaaclProfileMembershipResource{}
+
+func NewCaaclProfileMembershipResource() resource.Resource {
+	return &CaaclProfileMembershipResource{}
+}
+
+// CaaclProfileMembershipResource defines the resource implementation.
+type CaaclProfileMembershipResource struct {
+	client *ipa.Client
+}
+
+// CaaclProfileMembershipResourceModel describes the resource data model.
+type CaaclProfileMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Profile    types.String `tfsdk:"profile"`
+	Profiles   types.List   `tfsdk:"profiles"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *CaaclProfileMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl_profile_membership"
+}
+
+func (r *CaaclProfileMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("profile"),
+			path.MatchRoot("profiles"),
+		),
+	}
+}
+
+func (r *CaaclProfileMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL certificate profile membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profiles": schema.ListAttribute{
+				MarkdownDescription: "List of certificate profiles to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple CA ACL certificate profile membership resources on the same CA ACL. Mandatory for using profiles configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaaclProfileMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclProfileMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclProfileMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddProfileOptionalArgs{}
+
+	args := ipa.CaaclAddProfileArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Profile.IsNull() {
+		v := []string{data.Profile.ValueString()}
+		optArgs.Certprofile = &v
+		id = fmt.Sprintf("%s/p/%s", encodeSlash(data.Name.ValueString()), data.Profile.ValueString())
+	}
+	if !data.Profiles.IsNull() {
+		v := listStringValues(data.Profiles)
+		optArgs.Certprofile = &v
+		id = fmt.Sprintf("%s/mp/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.CaaclAddProfile(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL certificate profile membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL certificate profile membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclProfileMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclProfileMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caaclId, typeId, memberId, err := parseCaaclProfileMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_profile_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "p":
+		if res.Result.IpamembercertprofileCertprofile == nil || !isStringListContainsCaseInsensistive(res.Result.IpamembercertprofileCertprofile, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL certificate profile membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mp":
+		if !data.Profiles.IsNull() {
+			var diag diag.Diagnostics
+			data.Profiles, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Profiles, res.Result.IpamembercertprofileCertprofile))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclProfileMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclProfileMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	addedProfiles, deletedProfiles := diffStringLists(state.Profiles, data.Profiles)
+
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if len(addedProfiles) > 0 {
+		args := ipa.CaaclAddProfileArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclAddProfile(&args, &ipa.CaaclAddProfileOptionalArgs{Certprofile: &addedProfiles})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL certificate profile membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL certificate profile membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL certificate profile membership: %v", _v.Failed))
+		}
+	}
+	if len(deletedProfiles) > 0 {
+		args := ipa.CaaclRemoveProfileArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclRemoveProfile(&args, &ipa.CaaclRemoveProfileOptionalArgs{Certprofile: &deletedProfiles})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL certificate profile membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL certificate profile membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL certificate profile membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclProfileMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclProfileMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	caaclId, typeId, memberId, err := parseCaaclProfileMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_profile_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.CaaclRemoveProfileOptionalArgs{}
+
+	args := ipa.CaaclRemoveProfileArgs{
+		Cn: caaclId,
+	}
+
+	switch typeId {
+	case "p":
+		v := []string{memberId}
+		optArgs.Certprofile = &v
+	case "mp":
+		v := listStringValues(data.Profiles)
+		optArgs.Certprofile = &v
+	}
+
+	_, err = r.client.CaaclRemoveProfile(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa CA ACL certificate profile membership: %s", err))
+		return
+	}
+}
+
+func (r *CaaclProfileMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	caaclId, typeId, memberId, err := parseCaaclProfileMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "CA ACL not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), caaclId)...)
+
+	switch typeId {
+	case "p":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile"), memberId)...)
+	case "mp":
+		// The imported membership manages all the certificate profiles currently member of the CA ACL.
+		var profiles []string
+		if res.Result.IpamembercertprofileCertprofile != nil {
+			profiles = *res.Result.IpamembercertprofileCertprofile
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profiles"), profiles)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseCaaclProfileMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine CA ACL certificate profile membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	profile := idParts[2]
+
+	return name, _type, profile, nil
+}
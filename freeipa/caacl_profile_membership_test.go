@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaaclProfileMembership_simple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testProfileMembership := map[string]string{
+		"index":   "0",
+		"name":    "freeipa_caacl.caacl-0.name",
+		"profile": "\"caIPAserviceCert\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACaaclProfileMembership_resource(testProfileMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "profile", "caIPAserviceCert"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACaaclProfileMembership_resource(testProfileMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaaclProfileMembership_multiple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"profiles":   "[\"caIPAserviceCert\", \"IECUserRoles\"]",
+		"identifier": "\"profiles-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACaaclProfileMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_profile_membership.caacl-profile-membership-0", "profiles.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPACaaclProfileMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,387 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclServiceMembershipResource{}
+var _ resource.ResourceWithImportState = &CaaclServiceMembershipResource{}
+
+func NewCaaclServiceMembershipResource() resource.Resource {
+	return &CaaclServiceMembershipResource{}
+}
+
+// CaaclServiceMembershipResource defines the resource implementation.
+type CaaclServiceMembershipResource struct {
+	client *ipa.Client
+}
+
+// CaaclServiceMembershipResourceModel describes the resource data model.
+type CaaclServiceMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Service    types.String `tfsdk:"service"`
+	Services   types.List   `tfsdk:"services"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *CaaclServiceMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl_service_membership"
+}
+
+func (r *CaaclServiceMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("services"),
+		),
+	}
+}
+
+func (r *CaaclServiceMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL service membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Service principal to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"services": schema.ListAttribute{
+				MarkdownDescription: "List of service principals to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple CA ACL service membership resources on the same CA ACL. Mandatory for using services configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaaclServiceMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclServiceMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclServiceMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddServiceOptionalArgs{}
+
+	args := ipa.CaaclAddServiceArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Service.IsNull() {
+		v := []string{data.Service.ValueString()}
+		optArgs.Service = &v
+		id = fmt.Sprintf("%s/s/%s", encodeSlash(data.Name.ValueString()), data.Service.ValueString())
+	}
+	if !data.Services.IsNull() {
+		v := listStringValues(data.Services)
+		optArgs.Service = &v
+		id = fmt.Sprintf("%s/ms/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.CaaclAddService(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL service membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL service membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclServiceMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclServiceMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caaclId, typeId, memberId, err := parseCaaclServiceMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_service_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+			return
+		}
+	}
+
+	services := servicePrincipalsWithoutRealm(res.Result.MemberserviceService)
+
+	switch typeId {
+	case "s":
+		if !isStringListContainsCaseInsensistive(&services, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL service membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "ms":
+		if !data.Services.IsNull() {
+			var diag diag.Diagnostics
+			data.Services, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Services, &services))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclServiceMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclServiceMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	addedServices, deletedServices := diffStringLists(state.Services, data.Services)
+
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if len(addedServices) > 0 {
+		args := ipa.CaaclAddServiceArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclAddService(&args, &ipa.CaaclAddServiceOptionalArgs{Service: &addedServices})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL service membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL service membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL service membership: %v", _v.Failed))
+		}
+	}
+	if len(deletedServices) > 0 {
+		args := ipa.CaaclRemoveServiceArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.CaaclRemoveService(&args, &ipa.CaaclRemoveServiceOptionalArgs{Service: &deletedServices})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL service membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL service membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL service membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclServiceMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclServiceMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	caaclId, typeId, memberId, err := parseCaaclServiceMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_service_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.CaaclRemoveServiceOptionalArgs{}
+
+	args := ipa.CaaclRemoveServiceArgs{
+		Cn: caaclId,
+	}
+
+	switch typeId {
+	case "s":
+		v := []string{memberId}
+		optArgs.Service = &v
+	case "ms":
+		v := listStringValues(data.Services)
+		optArgs.Service = &v
+	}
+
+	_, err = r.client.CaaclRemoveService(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa CA ACL service membership: %s", err))
+		return
+	}
+}
+
+func (r *CaaclServiceMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	caaclId, typeId, memberId, err := parseCaaclServiceMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "CA ACL not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), caaclId)...)
+
+	switch typeId {
+	case "s":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), memberId)...)
+	case "ms":
+		// The imported membership manages all the services currently member of the CA ACL.
+		var services []string
+		if res.Result.MemberserviceService != nil {
+			for _, principal := range *res.Result.MemberserviceService {
+				services = append(services, strings.SplitN(principal, "@", 2)[0])
+			}
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("services"), services)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseCaaclServiceMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine CA ACL service membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	service := idParts[2]
+
+	return name, _type, service, nil
+}
+
+// servicePrincipalsWithoutRealm returns the service principals read from FreeIPA along with their name without the realm,
+// so that the members can be configured with or without the realm.
+func servicePrincipalsWithoutRealm(principals *[]string) []string {
+	var services []string
+	if principals == nil {
+		return services
+	}
+	for _, principal := range *principals {
+		services = append(services, principal)
+		if i := strings.LastIndex(principal, "@"); i > 0 {
+			services = append(services, principal[:i])
+		}
+	}
+	return services
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaaclServiceMembership_simple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testServiceMembership := map[string]string{
+		"index":   "0",
+		"name":    "freeipa_caacl.caacl-0.name",
+		"service": "freeipa_service.service-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACaaclServiceMembership_resource(testServiceMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_service_membership.caacl-service-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_service_membership.caacl-service-membership-0", "service", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACaaclServiceMembership_resource(testServiceMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaaclServiceMembership_multiple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"services":   "[freeipa_service.service-0.name]",
+		"identifier": "\"services-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACaaclServiceMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_service_membership.caacl-service-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_service_membership.caacl-service-membership-0", "services.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_service_membership.caacl-service-membership-0", "services.0", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACaaclServiceMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
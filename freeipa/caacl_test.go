@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaacl_full(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testCaaclModified := map[string]string{
+		"index":           "0",
+		"name":            "\"testacc-caacl\"",
+		"description":     "\"A disabled CA ACL for acceptance tests\"",
+		"enabled":         "false",
+		"profilecategory": "\"all\"",
+		"cacategory":      "\"all\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "description", "A CA ACL for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaaclModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "description", "A disabled CA ACL for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "enabled", "false"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "profilecategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_caacl.caacl-0", "cacategory", "all"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaaclModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,464 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaaclUserMembershipResource{}
+var _ resource.ResourceWithImportState = &CaaclUserMembershipResource{}
+
+func NewCaaclUserMembershipResource() resource.Resource {
+	return &CaaclUserMembershipResource{}
+}
+
+// CaaclUserMembershipResource defines the resource implementation.
+type CaaclUserMembershipResource struct {
+	client *ipa.Client
+}
+
+// CaaclUserMembershipResourceModel describes the resource data model.
+type CaaclUserMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Users      types.List   `tfsdk:"users"`
+	Group      types.String `tfsdk:"group"`
+	Groups     types.List   `tfsdk:"groups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *CaaclUserMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_caacl_user_membership"
+}
+
+func (r *CaaclUserMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("groups"),
+		),
+	}
+}
+
+func (r *CaaclUserMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA CA ACL user membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the CA ACL",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "List of users to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group to add to the CA ACL",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups to add to the CA ACL",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple CA ACL user membership resources on the same CA ACL. Mandatory for using users/groups configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaaclUserMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaaclUserMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CaaclUserMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CaaclAddUserOptionalArgs{}
+
+	args := ipa.CaaclAddUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+		id = fmt.Sprintf("%s/u/%s", encodeSlash(data.Name.ValueString()), data.User.ValueString())
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+		id = fmt.Sprintf("%s/g/%s", encodeSlash(data.Name.ValueString()), data.Group.ValueString())
+	}
+	if !data.Users.IsNull() || !data.Groups.IsNull() {
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+		id = fmt.Sprintf("%s/mu/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.CaaclAddUser(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL user membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL user membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclUserMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CaaclUserMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caaclId, typeId, memberId, err := parseCaaclUserMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_user_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] CA ACL not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "u":
+		if res.Result.MemberuserUser == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserUser, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL user membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "g":
+		if res.Result.MemberuserGroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserGroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] CA ACL user group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mu":
+		if !data.Users.IsNull() {
+			var diag diag.Diagnostics
+			data.Users, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Users, res.Result.MemberuserUser))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Groups.IsNull() {
+			var diag diag.Diagnostics
+			data.Groups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Groups, res.Result.MemberuserGroup))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CaaclUserMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CaaclUserMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.CaaclAddUserOptionalArgs{}
+
+	memberAddArgs := ipa.CaaclAddUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.CaaclRemoveUserOptionalArgs{}
+
+	memberDelArgs := ipa.CaaclRemoveUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Users.Equal(state.Users) {
+		addedUsers, deletedUsers := diffStringLists(state.Users, data.Users)
+		if len(addedUsers) > 0 {
+			memberAddOptArgs.User = &addedUsers
+			hasMemberAdd = true
+		}
+		if len(deletedUsers) > 0 {
+			memberDelOptArgs.User = &deletedUsers
+			hasMemberDel = true
+		}
+	}
+	if !data.Groups.Equal(state.Groups) {
+		addedGroups, deletedGroups := diffStringLists(state.Groups, data.Groups)
+		if len(addedGroups) > 0 {
+			memberAddOptArgs.Group = &addedGroups
+			hasMemberAdd = true
+		}
+		if len(deletedGroups) > 0 {
+			memberDelOptArgs.Group = &deletedGroups
+			hasMemberDel = true
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.CaaclAddUser(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa CA ACL user membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL user membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa CA ACL user membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.CaaclRemoveUser(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL user membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa CA ACL user membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa CA ACL user membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CaaclUserMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CaaclUserMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	caaclId, typeId, memberId, err := parseCaaclUserMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_caacl_user_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.CaaclRemoveUserOptionalArgs{}
+
+	args := ipa.CaaclRemoveUserArgs{
+		Cn: caaclId,
+	}
+
+	switch typeId {
+	case "u":
+		v := []string{memberId}
+		optArgs.User = &v
+	case "g":
+		v := []string{memberId}
+		optArgs.Group = &v
+	case "mu":
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+	}
+
+	_, err = r.client.CaaclRemoveUser(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa CA ACL user membership: %s", err))
+		return
+	}
+}
+
+func (r *CaaclUserMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	caaclId, typeId, memberId, err := parseCaaclUserMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.CaaclShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.CaaclShowArgs{
+		Cn: caaclId,
+	}
+
+	res, err := r.client.CaaclShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "CA ACL not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa CA ACL: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), caaclId)...)
+
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "mu":
+		// The imported membership manages all the users and user groups currently member of the CA ACL.
+		var users, groups []string
+		if res.Result.MemberuserUser != nil {
+			users = *res.Result.MemberuserUser
+		}
+		if res.Result.MemberuserGroup != nil {
+			groups = *res.Result.MemberuserGroup
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), users)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), groups)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseCaaclUserMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine CA ACL user membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	user := idParts[2]
+
+	return name, _type, user, nil
+}
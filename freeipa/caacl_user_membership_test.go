@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPACaaclUserMembership_simple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group\"",
+		"description": "\"User group test\"",
+	}
+	testUserMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_caacl.caacl-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testGroupMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_caacl.caacl-0.name",
+		"group": "freeipa_group.group-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPACaaclUserMembership_resource(testUserMembership) + testAccFreeIPACaaclUserMembership_resource(testGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "user", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-1", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-1", "group", "testacc-group"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPACaaclUserMembership_resource(testUserMembership) + testAccFreeIPACaaclUserMembership_resource(testGroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPACaaclUserMembership_multiple(t *testing.T) {
+	testCaacl := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-caacl\"",
+		"description": "\"A CA ACL for acceptance tests\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group\"",
+		"description": "\"User group test\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_caacl.caacl-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPACaaclUserMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "name", "testacc-caacl"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "users.0", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_caacl_user_membership.caacl-user-membership-0", "groups.0", "testacc-group"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACaacl_resource(testCaacl) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPACaaclUserMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
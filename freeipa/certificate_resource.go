@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client *ipa.Client
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Csr              types.String `tfsdk:"csr"`
+	Principal        types.String `tfsdk:"principal"`
+	Ca               types.String `tfsdk:"ca"`
+	ProfileId        types.String `tfsdk:"profile_id"`
+	RenewalWindow    types.Int64  `tfsdk:"renewal_window"`
+	RevocationReason types.Int64  `tfsdk:"revocation_reason"`
+	Certificate      types.String `tfsdk:"certificate"`
+	SerialNumber     types.String `tfsdk:"serial_number"`
+	Subject          types.String `tfsdk:"subject"`
+	Issuer           types.String `tfsdk:"issuer"`
+	ValidNotBefore   types.String `tfsdk:"valid_not_before"`
+	ValidNotAfter    types.String `tfsdk:"valid_not_after"`
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate resource.\nRequests a certificate signed by a FreeIPA certificate authority. The certificate is revoked on destruction and a new one is requested when the current one enters its renewal window.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, the serial number of the certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"csr": schema.StringAttribute{
+				MarkdownDescription: "Certificate signing request in PEM format",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "Principal the certificate is issued to (e.g. `HTTP/web.example.lan`, `host/web.example.lan` or a user login)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "Name of the certificate authority issuing the certificate",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ipa"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile_id": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile to use. The default profile of FreeIPA (`caIPAserviceCert`) is used when not set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"renewal_window": schema.Int64Attribute{
+				MarkdownDescription: "Number of days before the expiration of the certificate from which a new certificate is requested. The certificate is only renewed once expired when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"revocation_reason": schema.Int64Attribute{
+				MarkdownDescription: "Reason of the revocation of the certificate on destruction. Possible values are `0` (unspecified), `1` (key compromise), `2` (CA compromise), `3` (affiliation changed), `4` (superseded), `5` (cessation of operation), `6` (certificate hold), `8` (remove from CRL), `9` (privilege withdrawn), `10` (AA compromise)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3, 4, 5, 6, 8, 9, 10),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "Issued certificate in PEM format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Serial number of the certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "Issuer of the certificate",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_not_before": schema.StringAttribute{
+				MarkdownDescription: "Start of the validity period of the certificate (RFC3339)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid_not_after": schema.StringAttribute{
+				MarkdownDescription: "End of the validity period of the certificate (RFC3339)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan requests the replacement of the certificate when it enters its renewal window.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to renew on creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan CertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, state.ValidNotAfter.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Unable to parse the expiration date of certificate %s: %s", state.Id.ValueString(), err))
+		return
+	}
+	window := time.Duration(plan.RenewalWindow.ValueInt64()) * 24 * time.Hour
+	if time.Now().Add(window).Before(notAfter) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certificate %s expires on %s, a new certificate will be requested", state.Id.ValueString(), notAfter))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("certificate"))
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertRequestArgs{
+		Csr:       data.Csr.ValueString(),
+		Principal: data.Principal.ValueString(),
+	}
+	optArgs := ipa.CertRequestOptionalArgs{
+		Cacn: data.Ca.ValueStringPointer(),
+	}
+	if !data.ProfileId.IsNull() {
+		optArgs.ProfileID = data.ProfileId.ValueStringPointer()
+	}
+
+	res, err := r.client.CertRequest(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error requesting freeipa certificate: %s", err))
+		return
+	}
+	result, ok := res.Result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error requesting freeipa certificate: unexpected result %v", res.Result))
+		return
+	}
+	encoded, ok := result["certificate"].(string)
+	if !ok {
+		resp.Diagnostics.AddError("Client Error", "Error requesting freeipa certificate: no certificate issued")
+		return
+	}
+	cert, err := parseCertificate(encoded)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing freeipa certificate: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Requested freeipa certificate %s for %s", cert.SerialNumber.String(), data.Principal.ValueString()))
+
+	data.Id = types.StringValue(cert.SerialNumber.String())
+	data.Certificate = types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+	data.SerialNumber = types.StringValue(cert.SerialNumber.String())
+	data.Subject = types.StringValue(cert.Subject.String())
+	data.Issuer = types.StringValue(cert.Issuer.String())
+	data.ValidNotBefore = types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339))
+	data.ValidNotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The serial number is sent as a string, go-freeipa only supports the serial numbers that fit an int
+	// while FreeIPA issues random 128-bit serial numbers by default.
+	var res certificateShowResult
+	err := callRPC(ctx, r.client, "cert_show", []interface{}{data.SerialNumber.ValueString()}, certificateOptions(data.Ca), &res)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certificate not found")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate: %s", err))
+		return
+	}
+	if res.Result.Revoked != nil && *res.Result.Revoked {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certificate %s has been revoked", data.SerialNumber.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the renewal window and the revocation reason can be updated, they are not stored in FreeIPA.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Revoke freeipa certificate %s", data.SerialNumber.ValueString()))
+	options := certificateOptions(data.Ca)
+	options["revocation_reason"] = data.RevocationReason.ValueInt64()
+	err := callRPC(ctx, r.client, "cert_revoke", []interface{}{data.SerialNumber.ValueString()}, options, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") || strings.Contains(err.Error(), "already revoked") {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Certificate %s already revoked", data.SerialNumber.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Certificate %s revocation failed: %s", data.SerialNumber.ValueString(), err))
+		return
+	}
+}
+
+// certificateShowResult is the result of cert_show.
+type certificateShowResult struct {
+	Result struct {
+		Revoked *bool `json:"revoked"`
+	} `json:"result"`
+}
+
+// certificateOptions returns the options of the certificate calls, the certificate authority being optional.
+func certificateOptions(ca types.String) map[string]interface{} {
+	options := map[string]interface{}{}
+	if !ca.IsNull() {
+		options["cacn"] = ca.ValueString()
+	}
+	return options
+}
+
+// parseCertificate parses a certificate returned by FreeIPA, either base64 encoded DER or PEM.
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	if block, _ := pem.Decode([]byte(encoded)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
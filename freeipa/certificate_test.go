@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccFreeIPACertificate_csr returns a certificate signing request for the common name, as a heredoc string.
+func testAccFreeIPACertificate_csr(t *testing.T, cn string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: cn},
+		DNSNames: []string{cn},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("<<EOT\n%sEOT\n", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func TestAccFreeIPACertificate_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testCertificate := map[string]string{
+		"index":     "0",
+		"principal": "freeipa_service.service-0.name",
+		"csr":       testAccFreeIPACertificate_csr(t, "testacc-host-1.testacc.ipatest.lan"),
+	}
+	testCertificateModified := map[string]string{
+		"index":             "0",
+		"principal":         "freeipa_service.service-0.name",
+		"csr":               testCertificate["csr"],
+		"renewal_window":    "30",
+		"revocation_reason": "5",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACertificate_resource(testCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "ca", "ipa"),
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "subject", "CN=testacc-host-1.testacc.ipatest.lan,O=IPATEST.LAN"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "certificate"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "serial_number"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "issuer"),
+					resource.TestCheckResourceAttrSet("freeipa_certificate.certificate-0", "valid_not_after"),
+					resource.TestCheckResourceAttrPair("freeipa_certificate.certificate-0", "id", "freeipa_certificate.certificate-0", "serial_number"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACertificate_resource(testCertificate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAService_resource(testService) + testAccFreeIPACertificate_resource(testCertificateModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_certificate.certificate-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "renewal_window", "30"),
+					resource.TestCheckResourceAttr("freeipa_certificate.certificate-0", "revocation_reason", "5"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertProfileResource{}
+var _ resource.ResourceWithImportState = &CertProfileResource{}
+
+func NewCertProfileResource() resource.Resource {
+	return &CertProfileResource{}
+}
+
+// CertProfileResource defines the resource implementation.
+type CertProfileResource struct {
+	client *ipa.Client
+}
+
+// CertProfileResourceModel describes the resource data model.
+type CertProfileResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Config      types.String `tfsdk:"config"`
+	Store       types.Bool   `tfsdk:"store"`
+}
+
+func (r *CertProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certprofile"
+}
+
+func (r *CertProfileResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *CertProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA certificate profile resource.\nThe profile configuration is only read back from FreeIPA on import, changes made outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the certificate profile. It must match the `profileId` of the configuration.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile description",
+				Required:            true,
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Certificate profile configuration, in the Dogtag property list format (e.g. `file(\"profile.cfg\")`)",
+				Required:            true,
+			},
+			"store": schema.BoolAttribute{
+				MarkdownDescription: "Store the certificates issued with this profile",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *CertProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.CertprofileImportArgs{
+		Cn:          data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		File:        data.Config.ValueString(),
+	}
+	optArgs := ipa.CertprofileImportOptionalArgs{
+		Ipacertprofilestoreissued: data.Store.ValueBoolPointer(),
+	}
+
+	_, err := r.client.CertprofileImport(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa certificate profile: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.CertprofileShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.CertprofileShowOptionalArgs{
+		All: &all,
+	}
+	// The configuration is only read on import, Dogtag may return it in another form than the imported file.
+	if data.Config.IsNull() {
+		out := data.Name.ValueString() + ".cfg"
+		optArgs.Out = &out
+	}
+
+	res, err := r.client.CertprofileShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Certificate profile not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate profile: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa certificate profile %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa certificate profile %s", data.Name.ValueString()))
+		return
+	}
+
+	data.Description = types.StringValue(res.Result.Description)
+	if data.Config.IsNull() && res.Result.Config != nil {
+		data.Config = types.StringValue(*res.Result.Config)
+	}
+	if res.Result.Ipacertprofilestoreissued != nil {
+		data.Store = types.BoolValue(*res.Result.Ipacertprofilestoreissued)
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CertProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CertProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.CertprofileModOptionalArgs{}
+
+	args := ipa.CertprofileModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = data.Description.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Config.Equal(state.Config) {
+		optArgs.File = data.Config.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Store.Equal(state.Store) {
+		optArgs.Ipacertprofilestoreissued = data.Store.ValueBoolPointer()
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.CertprofileMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa certificate profile: %s", err))
+				return
+			}
+		}
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa certificate profile %s", data.Name.ValueString()))
+	args := ipa.CertprofileDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.CertprofileDel(&args, &ipa.CertprofileDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Certificate profile %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *CertProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const testAccCertProfileConfig = `<<EOT
+profileId=testaccProfile
+classId=caEnrollImpl
+desc=Acceptance test profile
+visible=false
+enable=true
+enableBy=ipara
+auth.instance_id=raCertAuth
+name=Acceptance test profile
+input.list=i1,i2
+input.i1.class_id=certReqInputImpl
+input.i2.class_id=submitterInfoInputImpl
+output.list=o1
+output.o1.class_id=certOutputImpl
+policyset.list=serverCertSet
+policyset.serverCertSet.list=1,2,3,4,5,6
+policyset.serverCertSet.1.constraint.class_id=subjectNameConstraintImpl
+policyset.serverCertSet.1.constraint.name=Subject Name Constraint
+policyset.serverCertSet.1.constraint.params.accept=true
+policyset.serverCertSet.1.constraint.params.pattern=CN=[^,]+,.+
+policyset.serverCertSet.1.default.class_id=subjectNameDefaultImpl
+policyset.serverCertSet.1.default.name=Subject Name Default
+policyset.serverCertSet.1.default.params.name=CN=$request.req_subject_name.cn$, O=IPATEST.LAN
+policyset.serverCertSet.2.constraint.class_id=validityConstraintImpl
+policyset.serverCertSet.2.constraint.name=Validity Constraint
+policyset.serverCertSet.2.constraint.params.notAfterCheck=false
+policyset.serverCertSet.2.constraint.params.notBeforeCheck=false
+policyset.serverCertSet.2.constraint.params.range=90
+policyset.serverCertSet.2.default.class_id=validityDefaultImpl
+policyset.serverCertSet.2.default.name=Validity Default
+policyset.serverCertSet.2.default.params.range=90
+policyset.serverCertSet.2.default.params.startTime=0
+policyset.serverCertSet.3.constraint.class_id=keyConstraintImpl
+policyset.serverCertSet.3.constraint.name=Key Constraint
+policyset.serverCertSet.3.constraint.params.keyParameters=2048,3072,4096,nistp256,nistp384,nistp521
+policyset.serverCertSet.3.constraint.params.keyType=-
+policyset.serverCertSet.3.default.class_id=userKeyDefaultImpl
+policyset.serverCertSet.3.default.name=Key Default
+policyset.serverCertSet.4.constraint.class_id=noConstraintImpl
+policyset.serverCertSet.4.constraint.name=No Constraint
+policyset.serverCertSet.4.default.class_id=authorityKeyIdentifierExtDefaultImpl
+policyset.serverCertSet.4.default.name=Authority Key Identifier Default
+policyset.serverCertSet.5.constraint.class_id=noConstraintImpl
+policyset.serverCertSet.5.constraint.name=No Constraint
+policyset.serverCertSet.5.default.class_id=subjectKeyIdentifierExtDefaultImpl
+policyset.serverCertSet.5.default.name=Subject Key Identifier Extension Default
+policyset.serverCertSet.5.default.params.critical=false
+policyset.serverCertSet.6.constraint.class_id=signingAlgConstraintImpl
+policyset.serverCertSet.6.constraint.name=No Constraint
+policyset.serverCertSet.6.constraint.params.signingAlgsAllowed=SHA256withRSA,SHA384withRSA,SHA512withRSA,SHA256withEC,SHA384withEC,SHA512withEC
+policyset.serverCertSet.6.default.class_id=signingAlgDefaultImpl
+policyset.serverCertSet.6.default.name=Signing Alg
+policyset.serverCertSet.6.default.params.signingAlg=-
+EOT
+`
+
+func TestAccFreeIPACertProfile_full(t *testing.T) {
+	testCertProfile := map[string]string{
+		"index":       "0",
+		"name":        "\"testaccProfile\"",
+		"description": "\"Acceptance test profile\"",
+		"config":      testAccCertProfileConfig,
+	}
+	testCertProfileModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testaccProfile\"",
+		"description": "\"Acceptance test profile without storage\"",
+		"config":      testAccCertProfileConfig,
+		"store":       "false",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertProfile_resource(testCertProfile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certprofile.certprofile-0", "name", "testaccProfile"),
+					resource.TestCheckResourceAttr("freeipa_certprofile.certprofile-0", "description", "Acceptance test profile"),
+					resource.TestCheckResourceAttr("freeipa_certprofile.certprofile-0", "store", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertProfile_resource(testCertProfile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertProfile_resource(testCertProfileModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_certprofile.certprofile-0", "description", "Acceptance test profile without storage"),
+					resource.TestCheckResourceAttr("freeipa_certprofile.certprofile-0", "store", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPACertProfile_resource(testCertProfileModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	krbclient "github.com/jcmturner/gokrb5/v8/client"
+	krbconfig "github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+)
+
+const (
+	ipaLoginPasswordPath = "/ipa/session/login_password"
+	ipaLoginKerberosPath = "/ipa/session/login_kerberos"
+	ipaLoginX509Path     = "/ipa/session/login_x509"
+)
+
+// freeipaAuthenticator opens a new session on a FreeIPA server.
+// The returned response carries the ipa_session cookie set by the server.
+type freeipaAuthenticator interface {
+	login(server string, rt http.RoundTripper) (*http.Response, error)
+}
+
+// passwordAuthenticator logs in with a username and a password.
+type passwordAuthenticator struct {
+	username string
+	password string
+}
+
+func (a *passwordAuthenticator) login(server string, rt http.RoundTripper) (*http.Response, error) {
+	data := url.Values{
+		"user":     []string{a.username},
+		"password": []string{a.password},
+	}
+	req, err := newLoginRequest(server, ipaLoginPasswordPath, data)
+	if err != nil {
+		return nil, err
+	}
+	return rt.RoundTrip(req)
+}
+
+// kerberosAuthenticator logs in by negotiating a SPNEGO token for the HTTP service of the server.
+type kerberosAuthenticator struct {
+	client *krbclient.Client
+}
+
+func newKeytabAuthenticator(principal string, keytabPath string, krb5ConfPath string) (*kerberosAuthenticator, error) {
+	cfg, err := krbconfig.Load(krb5ConfPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kerberos configuration %s: %v", krb5ConfPath, err)
+	}
+	kt, err := keytab.Load(keytabPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load keytab %s: %v", keytabPath, err)
+	}
+	username, realm := splitPrincipal(principal)
+	if realm == "" {
+		realm = cfg.LibDefaults.DefaultRealm
+	}
+	cl := krbclient.NewWithKeytab(username, realm, kt, cfg, krbclient.DisablePAFXFAST(true))
+	if err := cl.Login(); err != nil {
+		return nil, fmt.Errorf("kerberos login failed for %s@%s: %v", username, realm, err)
+	}
+	return &kerberosAuthenticator{client: cl}, nil
+}
+
+func newCCacheAuthenticator(ccachePath string, krb5ConfPath string) (*kerberosAuthenticator, error) {
+	cfg, err := krbconfig.Load(krb5ConfPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kerberos configuration %s: %v", krb5ConfPath, err)
+	}
+	cc, err := credentials.LoadCCache(ccachePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kerberos credential cache %s: %v", ccachePath, err)
+	}
+	cl, err := krbclient.NewFromCCache(cc, cfg, krbclient.DisablePAFXFAST(true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to use kerberos credential cache %s: %v", ccachePath, err)
+	}
+	return &kerberosAuthenticator{client: cl}, nil
+}
+
+func (a *kerberosAuthenticator) login(server string, rt http.RoundTripper) (*http.Response, error) {
+	req, err := newLoginRequest(server, ipaLoginKerberosPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	host := server
+	if h, _, err := net.SplitHostPort(server); err == nil {
+		host = h
+	}
+	if err := spnego.SetSPNEGOHeader(a.client, req, "HTTP/"+host); err != nil {
+		return nil, fmt.Errorf("unable to negotiate kerberos session with %s: %v", server, err)
+	}
+	return rt.RoundTrip(req)
+}
+
+// certificateAuthenticator logs in with the client certificate presented during the TLS handshake.
+// The username is only needed when the certificate is mapped to several users.
+type certificateAuthenticator struct {
+	username string
+}
+
+func (a *certificateAuthenticator) login(server string, rt http.RoundTripper) (*http.Response, error) {
+	data := url.Values{}
+	if a.username != "" {
+		data.Set("username", a.username)
+	}
+	req, err := newLoginRequest(server, ipaLoginX509Path, data)
+	if err != nil {
+		return nil, err
+	}
+	return rt.RoundTrip(req)
+}
+
+func newLoginRequest(server string, loginPath string, data url.Values) (*http.Request, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("https://%s%s", server, loginPath), strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "text/plain")
+	req.Header.Set("Referer", fmt.Sprintf("https://%s/ipa", server))
+	return req, nil
+}
+
+// splitPrincipal splits a kerberos principal (user@REALM) into its name and realm.
+func splitPrincipal(principal string) (string, string) {
+	i := strings.LastIndex(principal, "@")
+	if i < 0 {
+		return principal, ""
+	}
+	return principal[:i], principal[i+1:]
+}
+
+// defaultCCachePath returns the credential cache used by kinit, honoring KRB5CCNAME.
+// Only file credential caches can be read, the KEYRING, KCM and DIR caches are kept outside of a single file.
+func defaultCCachePath() (string, error) {
+	v := os.Getenv("KRB5CCNAME")
+	if v == "" {
+		return fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid()), nil
+	}
+	ccType, residual, found := strings.Cut(v, ":")
+	if !found || strings.HasPrefix(v, "/") {
+		return v, nil
+	}
+	if ccType != "FILE" {
+		return "", fmt.Errorf("the kerberos credential cache %s set in KRB5CCNAME is of type %s, only FILE credential caches are supported. "+
+			"Set KRB5CCNAME to a file (e.g. FILE:/tmp/krb5cc_terraform) before running kinit", v, ccType)
+	}
+	return residual, nil
+}
+
+// defaultKrb5ConfPath returns the kerberos configuration file, honoring KRB5_CONFIG.
+func defaultKrb5ConfPath() string {
+	if v := os.Getenv("KRB5_CONFIG"); v != "" {
+		return v
+	}
+	return "/etc/krb5.conf"
+}
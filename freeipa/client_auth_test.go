@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jcmturner/gokrb5/v8/test/testdata"
+)
+
+// testLoginServer records the login requests received by a FreeIPA server.
+func testLoginServer(t *testing.T, logins *[]*http.Request) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		*logins = append(*logins, r)
+		http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "session", Path: "/ipa"})
+	}))
+}
+
+// testKrb5Conf writes a kerberos configuration for the realm of the gokrb5 test data, with a KDC refusing connections.
+func testKrb5Conf(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	kdc := l.Addr().String()
+	l.Close()
+
+	conf := fmt.Sprintf(`[libdefaults]
+  default_realm = TEST.GOKRB5
+  udp_preference_limit = 1
+
+[realms]
+  TEST.GOKRB5 = {
+    kdc = %s
+  }
+`, kdc)
+	return testWriteFile(t, "krb5.conf", []byte(conf))
+}
+
+func testWriteFile(t *testing.T, name string, content []byte) string {
+	p := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(p, content, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return p
+}
+
+func testHexFile(t *testing.T, name string, content string) string {
+	b, err := hex.DecodeString(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return testWriteFile(t, name, b)
+}
+
+func TestSplitPrincipal(t *testing.T) {
+	for principal, want := range map[string][2]string{
+		"admin":                           {"admin", ""},
+		"admin@IPATEST.LAN":               {"admin", "IPATEST.LAN"},
+		"host/ci.ipatest.lan@IPATEST.LAN": {"host/ci.ipatest.lan", "IPATEST.LAN"},
+		"user@example.test@IPATEST.LAN":   {"user@example.test", "IPATEST.LAN"},
+	} {
+		name, realm := splitPrincipal(principal)
+		if name != want[0] || realm != want[1] {
+			t.Errorf("splitPrincipal(%q) = %q, %q, want %q, %q", principal, name, realm, want[0], want[1])
+		}
+	}
+}
+
+func TestDefaultCCachePath(t *testing.T) {
+	t.Setenv("KRB5CCNAME", "")
+	if got, err := defaultCCachePath(); err != nil || got != fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid()) {
+		t.Errorf("unexpected default credential cache: %q, %v", got, err)
+	}
+
+	for ccname, want := range map[string]string{
+		"/tmp/krb5cc_terraform":      "/tmp/krb5cc_terraform",
+		"FILE:/tmp/krb5cc_terraform": "/tmp/krb5cc_terraform",
+	} {
+		t.Setenv("KRB5CCNAME", ccname)
+		if got, err := defaultCCachePath(); err != nil || got != want {
+			t.Errorf("KRB5CCNAME=%s: got %q, %v, want %q", ccname, got, err, want)
+		}
+	}
+
+	for _, ccname := range []string{"KEYRING:persistent:1000", "KCM:1000", "DIR:/run/user/1000/krb5cc"} {
+		t.Setenv("KRB5CCNAME", ccname)
+		if _, err := defaultCCachePath(); err == nil || !strings.Contains(err.Error(), "only FILE credential caches are supported") {
+			t.Errorf("KRB5CCNAME=%s: unexpected error: %v", ccname, err)
+		}
+	}
+}
+
+func TestPasswordAuthenticator(t *testing.T) {
+	var logins []*http.Request
+	srv := testLoginServer(t, &logins)
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	resp, err := auth.login(server, srv.Client().Transport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if len(logins) != 1 {
+		t.Fatalf("expected one login, got %d", len(logins))
+	}
+	r := logins[0]
+	if r.URL.Path != ipaLoginPasswordPath || r.PostForm.Get("user") != "admin" || r.PostForm.Get("password") != "secret" {
+		t.Errorf("unexpected login request: %s %v", r.URL.Path, r.PostForm)
+	}
+	if r.Referer() != "https://"+server+"/ipa" {
+		t.Errorf("unexpected referer: %s", r.Referer())
+	}
+}
+
+func TestCertificateAuthenticator(t *testing.T) {
+	var logins []*http.Request
+	srv := testLoginServer(t, &logins)
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	for _, username := range []string{"", "admin"} {
+		auth := &certificateAuthenticator{username: username}
+		resp, err := auth.login(server, srv.Client().Transport)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(logins) != 2 {
+		t.Fatalf("expected two logins, got %d", len(logins))
+	}
+	for i, username := range []string{"", "admin"} {
+		r := logins[i]
+		if r.URL.Path != ipaLoginX509Path {
+			t.Errorf("unexpected login path: %s", r.URL.Path)
+		}
+		if _, ok := r.PostForm["username"]; ok != (username != "") || r.PostForm.Get("username") != username {
+			t.Errorf("unexpected username for %q: %v", username, r.PostForm)
+		}
+	}
+}
+
+func TestKeytabAuthenticator(t *testing.T) {
+	krb5Conf := testKrb5Conf(t)
+	keytabPath := testHexFile(t, "testuser1.keytab", testdata.KEYTAB_TESTUSER1_TEST_GOKRB5)
+
+	_, err := newKeytabAuthenticator("testuser1", filepath.Join(t.TempDir(), "missing.keytab"), krb5Conf)
+	if err == nil || !strings.HasPrefix(err.Error(), "unable to load keytab") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = newKeytabAuthenticator("testuser1", keytabPath, filepath.Join(t.TempDir(), "missing.conf"))
+	if err == nil || !strings.HasPrefix(err.Error(), "unable to load kerberos configuration") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// The keytab is loaded, the login fails as the KDC is unreachable.
+	_, err = newKeytabAuthenticator("testuser1", keytabPath, krb5Conf)
+	if err == nil || !strings.HasPrefix(err.Error(), "kerberos login failed for testuser1@TEST.GOKRB5") {
+		t.Errorf("the default realm should be used: %v", err)
+	}
+	_, err = newKeytabAuthenticator("testuser1@OTHER.REALM", keytabPath, krb5Conf)
+	if err == nil || !strings.HasPrefix(err.Error(), "kerberos login failed for testuser1@OTHER.REALM") {
+		t.Errorf("the realm of the principal should be used: %v", err)
+	}
+}
+
+func TestCCacheAuthenticator(t *testing.T) {
+	krb5Conf := testKrb5Conf(t)
+	ccachePath := testHexFile(t, "krb5cc", testdata.CCACHE_TEST)
+
+	_, err := newCCacheAuthenticator(filepath.Join(t.TempDir(), "missing"), krb5Conf)
+	if err == nil || !strings.HasPrefix(err.Error(), "unable to load kerberos credential cache") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	auth, err := newCCacheAuthenticator(ccachePath, krb5Conf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The tickets of the test credential cache are expired and the KDC is unreachable,
+	// the login fails before sending any request to the server.
+	var logins []*http.Request
+	srv := testLoginServer(t, &logins)
+	defer srv.Close()
+	_, err = auth.login("host.test.gokrb5:443", srv.Client().Transport)
+	if err == nil || !strings.HasPrefix(err.Error(), "unable to negotiate kerberos session with host.test.gokrb5:443") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(logins) != 0 {
+		t.Errorf("unexpected login requests: %d", len(logins))
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	krb5Conf := testKrb5Conf(t)
+	missingKeytab := filepath.Join(t.TempDir(), "missing.keytab")
+	missingCCache := filepath.Join(t.TempDir(), "missing")
+	t.Setenv("KRB5CCNAME", "FILE:"+missingCCache)
+
+	conf := freeipaProviderModel{
+		Username:          types.StringValue("admin"),
+		Password:          types.StringValue("secret"),
+		Principal:         types.StringValue("testuser1"),
+		Keytab:            types.StringValue(missingKeytab),
+		UseCCache:         types.BoolValue(true),
+		Krb5Config:        types.StringValue(krb5Conf),
+		ClientCertificate: types.StringValue("client.pem"),
+		ClientKey:         types.StringValue("client.key"),
+	}
+
+	// The keytab comes first.
+	_, err := newAuthenticator(context.Background(), &conf)
+	if err == nil || !strings.Contains(err.Error(), missingKeytab) {
+		t.Errorf("the keytab should be used: %v", err)
+	}
+
+	// Then the credential cache.
+	conf.Keytab = types.StringNull()
+	_, err = newAuthenticator(context.Background(), &conf)
+	if err == nil || !strings.Contains(err.Error(), missingCCache) {
+		t.Errorf("the credential cache should be used: %v", err)
+	}
+	t.Setenv("KRB5CCNAME", "KEYRING:persistent:1000")
+	_, err = newAuthenticator(context.Background(), &conf)
+	if err == nil || !strings.Contains(err.Error(), "only FILE credential caches are supported") {
+		t.Errorf("the keyring credential cache should be rejected: %v", err)
+	}
+
+	// The password is preferred to the client certificate.
+	conf.UseCCache = types.BoolValue(false)
+	auth, err := newAuthenticator(context.Background(), &conf)
+	if a, ok := auth.(*passwordAuthenticator); err != nil || !ok || a.username != "admin" || a.password != "secret" {
+		t.Errorf("the password should be used: %#v, %v", auth, err)
+	}
+
+	// The client certificate is used without password.
+	conf.Password = types.StringNull()
+	auth, err = newAuthenticator(context.Background(), &conf)
+	if a, ok := auth.(*certificateAuthenticator); err != nil || !ok || a.username != "admin" {
+		t.Errorf("the client certificate should be used: %#v, %v", auth, err)
+	}
+}
+
+func TestNewAuthenticator_certificate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		password, certificate, key string
+		certificateLogin           bool
+	}{
+		"certificate and key":           {certificate: "client.pem", key: "client.key", certificateLogin: true},
+		"certificate, key and password": {password: "secret", certificate: "client.pem", key: "client.key"},
+		"certificate without key":       {certificate: "client.pem"},
+		"password without certificate":  {password: "secret"},
+	} {
+		conf := freeipaProviderModel{
+			Username:          types.StringValue("admin"),
+			Password:          types.StringValue(tc.password),
+			ClientCertificate: types.StringValue(tc.certificate),
+			ClientKey:         types.StringValue(tc.key),
+		}
+		auth, err := newAuthenticator(context.Background(), &conf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if _, ok := auth.(*certificateAuthenticator); ok != tc.certificateLogin {
+			t.Errorf("%s: unexpected authenticator %T", name, auth)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// requestLimiter limits the number of concurrent requests and the rate of the requests sent to the FreeIPA servers.
+type requestLimiter struct {
+	next http.RoundTripper
+	// slots holds one element per request in flight, nil when the concurrency is not limited.
+	slots chan struct{}
+	// interval is the minimum delay between two requests, 0 when the rate is not limited.
+	interval time.Duration
+
+	mu       sync.Mutex
+	nextSlot time.Time
+}
+
+func newRequestLimiter(next http.RoundTripper, maxConcurrent int, requestsPerSecond float64) *requestLimiter {
+	l := &requestLimiter{
+		next: next,
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return l
+}
+
+func (l *requestLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-l.slots }()
+	}
+
+	if wait := l.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	return l.next.RoundTrip(req)
+}
+
+// reserve books the next request slot allowed by the rate and returns how long to wait for it.
+func (l *requestLimiter) reserve() time.Duration {
+	if l.interval == 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.nextSlot.Before(now) {
+		l.nextSlot = now
+	}
+	wait := l.nextSlot.Sub(now)
+	l.nextSlot = l.nextSlot.Add(l.interval)
+	return wait
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// retryPolicy defines how the calls to the FreeIPA API are retried.
+type retryPolicy struct {
+	maxAttempts int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	// timeout applies to each attempt, 0 means no timeout.
+	timeout time.Duration
+	// errorCodes are the IPA error codes (e.g. 4001 for NotFound) worth retrying.
+	errorCodes []int
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		maxAttempts: 1,
+		minBackoff:  time.Second,
+		maxBackoff:  30 * time.Second,
+	}
+}
+
+// retryReason returns why the outcome of a call should be retried, or an empty string when it should not.
+// Only the calls known not to have been processed by FreeIPA are retried, replaying a call that may have
+// been applied (e.g. a *_add call) would fail with DuplicateEntry or create the object twice.
+func (p *retryPolicy) retryReason(resp *http.Response, body []byte, err error) string {
+	if err != nil {
+		if isRequestNotProcessed(err) {
+			return err.Error()
+		}
+		return ""
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return fmt.Sprintf("http status %s", resp.Status)
+	}
+	if ipaErr := parseIPAError(body); ipaErr != nil && slices.Contains(p.errorCodes, ipaErr.Code) {
+		return fmt.Sprintf("%s (%d)", ipaErr.Name, ipaErr.Code)
+	}
+	return ""
+}
+
+// backoff returns the exponential delay, with jitter, to wait before the given attempt is retried.
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	d := p.minBackoff
+	for i := 1; i < attempt && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// ipaError is the error member of a FreeIPA JSON-RPC response.
+type ipaError struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// parseIPAError returns the error of a FreeIPA JSON-RPC response, nil when the call succeeded
+// or the body is not a JSON-RPC response.
+func parseIPAError(body []byte) *ipaError {
+	var res struct {
+		Error *ipaError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil
+	}
+	return res.Error
+}
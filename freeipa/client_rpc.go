@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+const ipaJSONPath = "/ipa/session/json"
+
+// rpcClients holds the HTTP client of each go-freeipa client, to send the calls go-freeipa cannot express,
+// such as the certificate calls with a serial number larger than an int.
+var rpcClients sync.Map
+
+type rpcClient struct {
+	server string
+	http   *http.Client
+}
+
+// registerRPCClient records the transport the go-freeipa client was connected with.
+func registerRPCClient(client *ipa.Client, server string, transport *freeipaTransport) {
+	rpcClients.Store(client, &rpcClient{
+		server: server,
+		http:   &http.Client{Transport: transport.httpTransport()},
+	})
+}
+
+// callRPC sends a JSON-RPC call through the transport of the go-freeipa client and decodes its result in result.
+// FreeIPA errors are returned in the format of go-freeipa, e.g. "NotFound (4001): ...".
+func callRPC(ctx context.Context, client *ipa.Client, method string, args []interface{}, options map[string]interface{}, result interface{}) error {
+	value, ok := rpcClients.Load(client)
+	if !ok {
+		return fmt.Errorf("no transport registered for the freeipa client")
+	}
+	rpc := value.(*rpcClient)
+
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"id":     0,
+		"method": method,
+		"params": []interface{}{args, options},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+rpc.server+ipaJSONPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := rpc.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected http status code: %d", resp.StatusCode)
+	}
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *ipaError       `json:"error"`
+	}
+	if err := json.Unmarshal(respBody, &res); err != nil {
+		return fmt.Errorf("unable to decode the %s response: %v", method, err)
+	}
+	if res.Error != nil {
+		return fmt.Errorf("%s (%d): %s", res.Error.Name, res.Error.Code, res.Error.Message)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(res.Result, result)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+func TestCallRPC(t *testing.T) {
+	const serial = "340282366920938463463374607431768211455"
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ipaLoginPasswordPath:
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "session", Path: "/ipa"})
+		case ipaJSONPath:
+			var call struct {
+				Method string         `json:"method"`
+				Params [2]interface{} `json:"params"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&call); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			args := call.Params[0].([]interface{})
+			options := call.Params[1].(map[string]interface{})
+			switch {
+			case call.Method == "cert_show" && args[0] == serial && options["cacn"] == "ipa":
+				_, _ = w.Write([]byte(`{"result":{"result":{"revoked":true},"value":"` + serial + `"},"error":null}`))
+			default:
+				_, _ = w.Write([]byte(`{"result":null,"error":{"code":4001,"name":"NotFound","message":"Certificate serial number not found"}}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	base := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	client := &ipa.Client{}
+	registerRPCClient(client, server, newFreeIPATransport(context.Background(), base, auth, []string{server}))
+
+	var res certificateShowResult
+	err := callRPC(context.Background(), client, "cert_show", []interface{}{serial}, map[string]interface{}{"cacn": "ipa"}, &res)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.Result.Revoked == nil || !*res.Result.Revoked {
+		t.Errorf("the certificate should be revoked: %+v", res)
+	}
+
+	err = callRPC(context.Background(), client, "cert_show", []interface{}{"1"}, nil, &res)
+	if err == nil || !strings.HasPrefix(err.Error(), "NotFound (4001): ") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = callRPC(context.Background(), &ipa.Client{}, "cert_show", []interface{}{serial}, nil, &res)
+	if err == nil {
+		t.Error("a client without transport should fail")
+	}
+}
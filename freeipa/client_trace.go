@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
+)
+
+const redactedValue = "***"
+
+// sensitiveArgs are the FreeIPA API arguments whose value is never written to the trace.
+var sensitiveArgs = []string{
+	"userpassword",
+	"password",
+	"old_password",
+	"new_password",
+	"otp",
+	"krbprincipalkey",
+	"ipatokenotpkey",
+	"ipatokenradiussecret",
+	"ipaidpclientsecret",
+	"ipasecret",
+	"current_password",
+	"secret",
+	"randompassword",
+	"uri",
+}
+
+// positionalArgs are the names of the positional arguments of the FreeIPA methods, the arguments
+// are masked by name as for the options.
+var positionalArgs = map[string][]string{
+	"passwd": {"principal", "password", "current_password"},
+}
+
+// attributeArgs are the options holding a list of "attribute=value" strings.
+var attributeArgs = []string{
+	"setattr",
+	"addattr",
+	"delattr",
+}
+
+// ipaRequest is a FreeIPA JSON-RPC request.
+type ipaRequest struct {
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// traceRequest writes a FreeIPA API call as structured log fields, with the sensitive arguments masked.
+func traceRequest(ctx context.Context, reqBody []byte, resp *http.Response, respBody []byte, err error, duration time.Duration, attempts int) {
+	var req ipaRequest
+	if json.Unmarshal(reqBody, &req) != nil {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"ipa_method":  req.Method,
+		"duration_ms": duration.Milliseconds(),
+		"attempts":    attempts,
+	}
+	if len(req.Params) > 0 {
+		var args []interface{}
+		if json.Unmarshal(req.Params[0], &args) == nil {
+			fields["ipa_args"] = redactPositionalArgs(req.Method, args)
+		}
+	}
+	if len(req.Params) > 1 {
+		var options map[string]interface{}
+		if json.Unmarshal(req.Params[1], &options) == nil {
+			fields["ipa_options"] = redactArgs(options)
+		}
+	}
+
+	switch {
+	case err != nil:
+		fields["error"] = err.Error()
+	case resp != nil:
+		fields["http_status"] = resp.StatusCode
+		if ipaErr := parseIPAError(respBody); ipaErr != nil {
+			fields["ipa_error_code"] = ipaErr.Code
+			fields["ipa_error_name"] = ipaErr.Name
+			fields["ipa_error_message"] = ipaErr.Message
+		}
+	}
+
+	tflog.Debug(ctx, "[DEBUG] freeipa api call", fields)
+}
+
+// redactPositionalArgs returns a copy of the positional arguments of a method with the sensitive values masked.
+// The calls of a batch are masked according to their own method.
+func redactPositionalArgs(method string, args []interface{}) []interface{} {
+	names := positionalArgs[method]
+	redacted := make([]interface{}, len(args))
+	for i, v := range args {
+		switch {
+		case i < len(names) && isSensitiveArg(names[i]):
+			redacted[i] = redactedValue
+		case method == "batch":
+			redacted[i] = redactBatchCall(v)
+		default:
+			redacted[i] = redactValue(v)
+		}
+	}
+	return redacted
+}
+
+// redactBatchCall masks the sensitive values of a call of a batch, {"method": ..., "params": [args, options]}.
+func redactBatchCall(call interface{}) interface{} {
+	c, ok := call.(map[string]interface{})
+	if !ok {
+		return redactValue(call)
+	}
+	method, _ := c["method"].(string)
+	params, ok := c["params"].([]interface{})
+	if !ok {
+		return redactArgs(c)
+	}
+	redactedParams := make([]interface{}, len(params))
+	for i, p := range params {
+		args, isList := p.([]interface{})
+		switch {
+		case i == 0 && isList:
+			redactedParams[i] = redactPositionalArgs(method, args)
+		default:
+			redactedParams[i] = redactValue(p)
+		}
+	}
+	redacted := redactArgs(c)
+	redacted["params"] = redactedParams
+	return redacted
+}
+
+// redactArgs returns a copy of the options with the values of the sensitive arguments masked,
+// including the options nested in maps and lists.
+func redactArgs(options map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		switch {
+		case isSensitiveArg(k):
+			redacted[k] = redactedValue
+		case slices.Contains(attributeArgs, strings.ToLower(k)):
+			redacted[k] = redactAttributes(v)
+		default:
+			redacted[k] = redactValue(v)
+		}
+	}
+	return redacted
+}
+
+// redactValue returns a copy of the value with the sensitive arguments of the nested maps masked.
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return redactArgs(value)
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, item := range value {
+			redacted[i] = redactValue(item)
+		}
+		return redacted
+	}
+	return v
+}
+
+// redactAttributes masks the values of the sensitive attributes of "attribute=value" strings (setattr, addattr, delattr).
+func redactAttributes(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		if name, _, found := strings.Cut(value, "="); found && isSensitiveArg(strings.TrimSpace(name)) {
+			return name + "=" + redactedValue
+		}
+		return value
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, item := range value {
+			redacted[i] = redactAttributes(item)
+		}
+		return redacted
+	}
+	return redactValue(v)
+}
+
+func isSensitiveArg(name string) bool {
+	return slices.Contains(sensitiveArgs, strings.ToLower(name))
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactArgs(t *testing.T) {
+	options := map[string]interface{}{
+		"givenname":    "John",
+		"userpassword": "secret",
+		"nested": map[string]interface{}{
+			"ipaTokenOTPKey": "secret",
+		},
+	}
+
+	redacted := redactArgs(options)
+	if redacted["givenname"] != "John" {
+		t.Fatalf("unexpected givenname: %v", redacted["givenname"])
+	}
+	if redacted["userpassword"] != redactedValue {
+		t.Fatalf("userpassword is not masked: %v", redacted["userpassword"])
+	}
+	if nested := redacted["nested"].(map[string]interface{}); nested["ipaTokenOTPKey"] != redactedValue {
+		t.Fatalf("nested ipatokenotpkey is not masked: %v", nested["ipaTokenOTPKey"])
+	}
+	// The options of the request are left untouched.
+	if options["userpassword"] != "secret" {
+		t.Fatalf("the options were modified: %v", options["userpassword"])
+	}
+}
+
+func TestTraceRequest(t *testing.T) {
+	calls := []string{
+		`{"method": "passwd", "params": [["admin", "s3cret-1", "s3cret-2"], {}]}`,
+		`{"method": "radiusproxy_add", "params": [["radius"], {"ipatokenradiusserver": "radius.example.lan", "ipatokenradiussecret": "s3cret-1"}]}`,
+		`{"method": "idp_mod", "params": [["keycloak"], {"setattr": ["ipaidpclientsecret=s3cret-1", "description=IdP"]}]}`,
+		`{"method": "batch", "params": [[{"method": "user_add", "params": [["jdoe"], {"givenname": "John", "userpassword": "s3cret-1"}]}, {"method": "passwd", "params": [["jdoe", "s3cret-2"], {}]}], {}]}`,
+	}
+
+	for _, call := range calls {
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+		traceRequest(ctx, []byte(call), &http.Response{StatusCode: http.StatusOK}, nil, nil, time.Millisecond, 1)
+
+		if output.Len() == 0 {
+			t.Fatalf("the call is not traced: %s", call)
+		}
+		if strings.Contains(output.String(), "s3cret") {
+			t.Fatalf("a secret is written to the trace: %s", output.String())
+		}
+		if !strings.Contains(output.String(), redactedValue) {
+			t.Fatalf("the secrets are not masked: %s", output.String())
+		}
+	}
+}
+
+// sensitiveAttributeArgs maps the sensitive schema attributes to the FreeIPA API argument holding their value
+// when the names differ. An empty argument marks a value that is never sent in a FreeIPA API call.
+var sensitiveAttributeArgs = map[string]string{
+	"provider.extra_headers":          "",
+	"provider.client_key":             "",
+	"freeipa_host.generated_password": "randompassword",
+	"freeipa_idp.secret":              "ipaidpclientsecret",
+	"freeipa_otp_token.uri":           "uri",
+	"freeipa_radius_proxy.secret":     "ipatokenradiussecret",
+}
+
+// TestSensitiveArgs_schemas checks that the sensitive attributes of the provider schemas are masked in the trace.
+func TestSensitiveArgs_schemas(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	schemas := map[string]*tfprotov6.Schema{"provider": resp.Provider}
+	for name, schema := range resp.ResourceSchemas {
+		schemas[name] = schema
+	}
+	for name, schema := range resp.DataSourceSchemas {
+		schemas["data."+name] = schema
+	}
+
+	for name, schema := range schemas {
+		for _, attr := range sensitiveSchemaAttributes(schema.Block) {
+			arg, ok := sensitiveAttributeArgs[name+"."+attr]
+			if !ok {
+				arg = attr
+			}
+			if arg != "" && !isSensitiveArg(arg) {
+				t.Errorf("the sensitive attribute %s.%s is sent as %s, add it to sensitiveArgs or map it in sensitiveAttributeArgs", name, attr, arg)
+			}
+		}
+	}
+}
+
+// sensitiveSchemaAttributes returns the names of the sensitive attributes of a block, nested attributes and blocks included.
+func sensitiveSchemaAttributes(block *tfprotov6.SchemaBlock) []string {
+	var names []string
+	if block == nil {
+		return names
+	}
+	var walk func(attrs []*tfprotov6.SchemaAttribute)
+	walk = func(attrs []*tfprotov6.SchemaAttribute) {
+		for _, attr := range attrs {
+			if attr.Sensitive {
+				names = append(names, attr.Name)
+			}
+			if attr.NestedType != nil {
+				walk(attr.NestedType.Attributes)
+			}
+		}
+	}
+	walk(block.Attributes)
+	for _, nested := range block.BlockTypes {
+		names = append(names, sensitiveSchemaAttributes(nested.Block)...)
+	}
+	return names
+}
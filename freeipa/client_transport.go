@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// freeipaTransport sits between the go-freeipa client and the FreeIPA servers.
+// The go-freeipa client only knows how to log in with a password on a single server, so the transport
+// takes over the login requests, hands them to the configured authenticator and keeps one session per server,
+// logging in again when a session expires.
+// Requests are sent to the active server and fail over to the next one when it is unreachable. A request is only
+// sent to another server when the first one did not process it, so that a write is never applied twice.
+// Failed calls are retried according to the retry policy.
+type freeipaTransport struct {
+	ctx     context.Context
+	base    http.RoundTripper
+	auth    freeipaAuthenticator
+	servers []string
+	retry   retryPolicy
+	// trace logs every API call, with the sensitive arguments masked.
+	trace bool
+
+	// loginMu serializes the logins so that concurrent requests open a single session per server.
+	loginMu  sync.Mutex
+	mu       sync.Mutex
+	active   int
+	sessions map[string][]*http.Cookie
+}
+
+func newFreeIPATransport(ctx context.Context, base http.RoundTripper, auth freeipaAuthenticator, servers []string) *freeipaTransport {
+	return &freeipaTransport{
+		ctx:      ctx,
+		base:     base,
+		auth:     auth,
+		servers:  servers,
+		retry:    defaultRetryPolicy(),
+		sessions: make(map[string][]*http.Cookie),
+	}
+}
+
+// httpTransport returns the transport to give to ipa.Connect.
+// ipa.Connect expects an *http.Transport, the freeipaTransport is therefore registered
+// as the https protocol handler of an otherwise empty transport.
+func (t *freeipaTransport) httpTransport() *http.Transport {
+	tspt := &http.Transport{}
+	tspt.RegisterProtocol("https", t)
+	return tspt
+}
+
+func (t *freeipaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		resp, respBody, err := t.attempt(req, body)
+		reason := t.retry.retryReason(resp, respBody, err)
+		if reason == "" || attempt >= t.retry.maxAttempts {
+			if t.trace && req.URL.Path != ipaLoginPasswordPath {
+				traceRequest(t.requestContext(req), body, resp, respBody, err, time.Since(start), attempt)
+			}
+			return resp, err
+		}
+
+		wait := t.retry.backoff(attempt)
+		tflog.Warn(t.requestContext(req), fmt.Sprintf("[WARN] freeipa request %s failed: %s, retrying in %s (attempt %d/%d)", req.URL.Path, reason, wait, attempt+1, t.retry.maxAttempts))
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// requestContext returns the context to log the request with.
+// go-freeipa does not attach a context to its requests, the context of the provider is used instead.
+func (t *freeipaTransport) requestContext(req *http.Request) context.Context {
+	if req.Context() == context.Background() {
+		return t.ctx
+	}
+	return req.Context()
+}
+
+// attempt sends the request once to the active server, failing over to the next servers when it is unavailable.
+// The response body is read within the attempt timeout and returned along with the response.
+func (t *freeipaTransport) attempt(req *http.Request, body []byte) (*http.Response, []byte, error) {
+	if t.retry.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), t.retry.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	first := t.activeServer()
+	var lastErr error
+	for i := range t.servers {
+		idx := (first + i) % len(t.servers)
+		server := t.servers[idx]
+
+		var resp *http.Response
+		var err error
+		if req.URL.Path == ipaLoginPasswordPath {
+			resp, err = t.login(server)
+		} else {
+			resp, err = t.forward(server, req, body)
+		}
+		if err == nil && !isServerUnavailable(resp) {
+			respBody, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			t.setActiveServer(idx)
+			return resp, respBody, nil
+		}
+
+		if err == nil {
+			err = &requestNotProcessedError{err: fmt.Errorf("server %s is unavailable: %s", server, resp.Status)}
+			resp.Body.Close()
+		}
+		if req.URL.Path != ipaLoginPasswordPath && !isRequestNotProcessed(err) {
+			// The server may have applied the call before failing, it must not be sent to another server.
+			return nil, nil, err
+		}
+		tflog.Warn(t.requestContext(req), fmt.Sprintf("[WARN] freeipa request to %s failed: %s", server, err))
+		lastErr = err
+	}
+	return nil, nil, lastErr
+}
+
+// forward sends the request to the given server with the session opened on that server.
+// When the session has expired, the transport logs in again and replays the request once.
+func (t *freeipaTransport) forward(server string, req *http.Request, body []byte) (*http.Response, error) {
+	cookies, err := t.session(server)
+	if err != nil {
+		return nil, &requestNotProcessedError{err: err}
+	}
+
+	resp, err := t.send(server, req, body, cookies)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	tflog.Debug(t.ctx, fmt.Sprintf("[DEBUG] freeipa session on %s expired, logging in again", server))
+	t.expireSession(server, cookies)
+	cookies, err = t.session(server)
+	if err != nil {
+		return nil, &requestNotProcessedError{err: err}
+	}
+	return t.send(server, req, body, cookies)
+}
+
+// send sends the request to the given server. The errors raised before the request was written,
+// such as dial or TLS handshake errors, are reported as requestNotProcessedError.
+func (t *freeipaTransport) send(server string, req *http.Request, body []byte, cookies []*http.Cookie) (*http.Response, error) {
+	// WroteRequest is called from the goroutine writing the request.
+	var wrote atomic.Bool
+	trace := &httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			wrote.Store(true)
+		},
+	}
+	r := req.Clone(httptrace.WithClientTrace(req.Context(), trace))
+	r.URL.Host = server
+	r.Host = server
+	r.Header.Set("Referer", fmt.Sprintf("https://%s/ipa", server))
+	r.Header.Del("Cookie")
+	for _, c := range cookies {
+		r.AddCookie(c)
+	}
+	if body != nil {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil && !wrote.Load() {
+		return nil, &requestNotProcessedError{err: err}
+	}
+	return resp, err
+}
+
+// login opens a new session on the given server.
+func (t *freeipaTransport) login(server string) (*http.Response, error) {
+	t.loginMu.Lock()
+	defer t.loginMu.Unlock()
+
+	return t.doLogin(server)
+}
+
+// session returns the cookies of the session opened on the given server, logging in when there is none.
+func (t *freeipaTransport) session(server string) ([]*http.Cookie, error) {
+	t.loginMu.Lock()
+	defer t.loginMu.Unlock()
+
+	if cookies := t.sessionCookies(server); cookies != nil {
+		return cookies, nil
+	}
+
+	tflog.Debug(t.ctx, fmt.Sprintf("[DEBUG] freeipa opening session on %s", server))
+	resp, err := t.doLogin(server)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("login on %s failed: %s", server, resp.Status)
+	}
+	return t.sessionCookies(server), nil
+}
+
+func (t *freeipaTransport) doLogin(server string) (*http.Response, error) {
+	resp, err := t.auth.login(server, t.base)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		t.mu.Lock()
+		t.sessions[server] = resp.Cookies()
+		t.mu.Unlock()
+	}
+	return resp, nil
+}
+
+func (t *freeipaTransport) sessionCookies(server string) []*http.Cookie {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.sessions[server]
+}
+
+// expireSession forgets the session of the given server, unless another request already replaced it.
+func (t *freeipaTransport) expireSession(server string, cookies []*http.Cookie) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := t.sessions[server]
+	if len(current) == 0 || len(cookies) == 0 || current[0] == cookies[0] {
+		delete(t.sessions, server)
+	}
+}
+
+func (t *freeipaTransport) activeServer() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.active
+}
+
+func (t *freeipaTransport) setActiveServer(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.active != idx {
+		tflog.Info(t.ctx, fmt.Sprintf("[INFO] freeipa failing over to %s", t.servers[idx]))
+	}
+	t.active = idx
+}
+
+// extraHeadersRoundTripper adds the configured headers to every request, logins included.
+type extraHeadersRoundTripper struct {
+	next    http.RoundTripper
+	headers http.Header
+}
+
+func (rt *extraHeadersRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	for name, values := range rt.headers {
+		r.Header[name] = values
+	}
+	return rt.next.RoundTrip(r)
+}
+
+// isServerUnavailable reports whether the response comes from a server (or a proxy in front of it)
+// that cannot serve requests at the moment. A 502 or 504 response does not tell whether the call
+// was applied, only a 503 response guarantees that the request was not processed.
+func isServerUnavailable(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// requestNotProcessedError is returned when a request failed before reaching the FreeIPA API,
+// it can safely be sent again to the same or to another server.
+type requestNotProcessedError struct {
+	err error
+}
+
+func (e *requestNotProcessedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *requestNotProcessedError) Unwrap() error {
+	return e.err
+}
+
+// isRequestNotProcessed reports whether the request failed before reaching the FreeIPA API.
+func isRequestNotProcessed(err error) bool {
+	var notProcessed *requestNotProcessedError
+	return errors.As(err, &notProcessed)
+}
+
+// discoverServers looks up the FreeIPA servers of a domain from the DNS SRV records
+// published by IPA (_ldap._tcp first, _kerberos._tcp otherwise), ordered by priority and weight.
+func discoverServers(domain string) ([]string, error) {
+	var lastErr error
+	for _, service := range []string{"ldap", "kerberos"} {
+		_, records, err := net.LookupSRV(service, "tcp", domain)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		var servers []string
+		for _, r := range records {
+			servers = append(servers, strings.TrimSuffix(r.Target, "."))
+		}
+		if len(servers) > 0 {
+			return servers, nil
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no SRV record found")
+	}
+	return nil, fmt.Errorf("unable to discover FreeIPA servers of %s: %v", domain, lastErr)
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testIPAServer emulates the login and json endpoints of a FreeIPA server.
+func testIPAServer(t *testing.T, name string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ipaLoginPasswordPath:
+			_ = r.ParseForm()
+			if r.PostForm.Get("user") != "admin" || r.PostForm.Get("password") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: name, Path: "/ipa"})
+		case "/ipa/session/json":
+			c, err := r.Cookie("ipa_session")
+			if err != nil || c.Value != name {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.Referer() != "https://"+r.Host+"/ipa" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(name + ":" + string(body)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func testTransportClient(servers []string) *http.Client {
+	base := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	return &http.Client{Transport: newFreeIPATransport(context.Background(), base, auth, servers).httpTransport()}
+}
+
+func testTransportCall(t *testing.T, client *http.Client, server string) string {
+	resp, err := client.Post("https://"+server+"/ipa/session/json", "application/json", strings.NewReader(`{"method":"ping"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status: %s", resp.Status)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return string(body)
+}
+
+func TestFreeIPATransport_login(t *testing.T) {
+	srv := testIPAServer(t, "replica-1")
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	client := testTransportClient([]string{server})
+	resp, err := client.PostForm("https://"+server+ipaLoginPasswordPath, map[string][]string{"user": {"ignored"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("login through the configured authenticator failed: %s", resp.Status)
+	}
+
+	if got := testTransportCall(t, client, server); got != `replica-1:{"method":"ping"}` {
+		t.Fatalf("unexpected response: %s", got)
+	}
+}
+
+func TestFreeIPATransport_failover(t *testing.T) {
+	down := testIPAServer(t, "replica-1")
+	downServer := strings.TrimPrefix(down.URL, "https://")
+	down.Close()
+	up := testIPAServer(t, "replica-2")
+	defer up.Close()
+	upServer := strings.TrimPrefix(up.URL, "https://")
+
+	client := testTransportClient([]string{downServer, upServer})
+
+	if got := testTransportCall(t, client, downServer); got != `replica-2:{"method":"ping"}` {
+		t.Fatalf("unexpected response: %s", got)
+	}
+	// The replica stays active for the next requests.
+	if got := testTransportCall(t, client, downServer); got != `replica-2:{"method":"ping"}` {
+		t.Fatalf("unexpected response: %s", got)
+	}
+}
+
+func TestFreeIPATransport_noFailoverAfterWrite(t *testing.T) {
+	// The first replica receives the call and drops the connection without answering.
+	broken := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ipaLoginPasswordPath {
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "replica-1", Path: "/ipa"})
+			return
+		}
+		_, _ = io.ReadAll(r.Body)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer broken.Close()
+	brokenServer := strings.TrimPrefix(broken.URL, "https://")
+	calls := 0
+	up := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ipaLoginPasswordPath {
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "replica-2", Path: "/ipa"})
+			return
+		}
+		calls++
+	}))
+	defer up.Close()
+	upServer := strings.TrimPrefix(up.URL, "https://")
+
+	client := testTransportClient([]string{brokenServer, upServer})
+	resp, err := client.Post("https://"+brokenServer+"/ipa/session/json", "application/json", strings.NewReader(`{"method":"user_add"}`))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected an error, got %s", resp.Status)
+	}
+	if calls != 0 {
+		t.Fatalf("the call may have been applied by the first replica, it must not be sent to the second one")
+	}
+}
+
+func TestFreeIPATransport_retry(t *testing.T) {
+	calls := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ipaLoginPasswordPath {
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "replica-1", Path: "/ipa"})
+			return
+		}
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			_, _ = w.Write([]byte(`{"result": null, "error": {"code": 4001, "name": "NotFound", "message": "no such entry"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"result": {"value": "ok"}, "error": null}`))
+		}
+	}))
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	base := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	transport := newFreeIPATransport(context.Background(), base, auth, []string{server})
+	transport.retry = retryPolicy{
+		maxAttempts: 3,
+		minBackoff:  time.Millisecond,
+		maxBackoff:  10 * time.Millisecond,
+		errorCodes:  []int{4001},
+	}
+	client := &http.Client{Transport: transport.httpTransport()}
+
+	if got := testTransportCall(t, client, server); got != `{"result": {"value": "ok"}, "error": null}` {
+		t.Fatalf("unexpected response: %s", got)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestFreeIPATransport_noRetryAfterServerError(t *testing.T) {
+	calls := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ipaLoginPasswordPath {
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "replica-1", Path: "/ipa"})
+			return
+		}
+		// The call may have been applied before the server failed.
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	base := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	transport := newFreeIPATransport(context.Background(), base, auth, []string{server})
+	transport.retry = retryPolicy{
+		maxAttempts: 3,
+		minBackoff:  time.Millisecond,
+		maxBackoff:  10 * time.Millisecond,
+	}
+	client := &http.Client{Transport: transport.httpTransport()}
+
+	resp, err := client.Post("https://"+server+"/ipa/session/json", "application/json", strings.NewReader(`{"method":"otptoken_add"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Fatalf("expected a single attempt, got %d", calls)
+	}
+}
+
+func TestFreeIPATransport_sessionExpired(t *testing.T) {
+	logins := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ipaLoginPasswordPath {
+			logins++
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: fmt.Sprintf("session-%d", logins), Path: "/ipa"})
+			return
+		}
+		// Only the last session is valid, the previous ones have expired.
+		c, err := r.Cookie("ipa_session")
+		if err != nil || c.Value != fmt.Sprintf("session-%d", logins) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(c.Value))
+	}))
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	client := testTransportClient([]string{server})
+	if got := testTransportCall(t, client, server); got != "session-1" {
+		t.Fatalf("unexpected response: %s", got)
+	}
+
+	// Expire the session on the server side.
+	logins++
+	if got := testTransportCall(t, client, server); got != "session-3" {
+		t.Fatalf("unexpected response: %s", got)
+	}
+	if logins != 3 {
+		t.Fatalf("expected a new login, got %d logins", logins)
+	}
+}
+
+func TestFreeIPATransport_extraHeaders(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Proxy-Auth") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path == ipaLoginPasswordPath {
+			http.SetCookie(w, &http.Cookie{Name: "ipa_session", Value: "replica-1", Path: "/ipa"})
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+	server := strings.TrimPrefix(srv.URL, "https://")
+
+	base := &extraHeadersRoundTripper{
+		next:    &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		headers: http.Header{"X-Proxy-Auth": {"token"}},
+	}
+	auth := &passwordAuthenticator{username: "admin", password: "secret"}
+	client := &http.Client{Transport: newFreeIPATransport(context.Background(), base, auth, []string{server}).httpTransport()}
+
+	if got := testTransportCall(t, client, server); got != "ok" {
+		t.Fatalf("unexpected response: %s", got)
+	}
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DelegationResource{}
+var _ resource.ResourceWithImportState = &DelegationResource{}
+
+func NewDelegationResource() resource.Resource {
+	return &DelegationResource{}
+}
+
+// DelegationResource defines the resource implementation.
+type DelegationResource struct {
+	client *ipa.Client
+}
+
+// DelegationResourceModel describes the resource data model.
+type DelegationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Attributes  types.Set    `tfsdk:"attributes"`
+	Group       types.String `tfsdk:"group"`
+	MemberGroup types.String `tfsdk:"member_group"`
+}
+
+func (r *DelegationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegation"
+}
+
+func (r *DelegationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *DelegationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA delegation rule resource.\nA delegation rule allows the members of a user group to manage attributes of the members of another user group.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the delegation rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions to grant (`read`, `write`). Defaults to `write` in FreeIPA.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write")),
+				},
+			},
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes the members of `group` are allowed to manage",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group granted the permissions",
+				Required:            true,
+			},
+			"member_group": schema.StringAttribute{
+				MarkdownDescription: "User group whose members' entries can be managed",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *DelegationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DelegationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DelegationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.DelegationAddOptionalArgs{}
+
+	args := ipa.DelegationAddArgs{
+		Aciname:  data.Name.ValueString(),
+		Attrs:    setStringValues(data.Attributes),
+		Group:    data.Group.ValueString(),
+		Memberof: data.MemberGroup.ValueString(),
+	}
+
+	if len(data.Permissions.Elements()) > 0 {
+		v := setStringValues(data.Permissions)
+		optArgs.Permissions = &v
+	}
+
+	_, err := r.client.DelegationAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa delegation rule: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DelegationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DelegationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.DelegationShowArgs{
+		Aciname: data.Name.ValueString(),
+	}
+	optArgs := ipa.DelegationShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.DelegationShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Delegation rule not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa delegation rule: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa delegation rule %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa delegation rule %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Permissions != nil && !data.Permissions.IsNull() {
+		data.Permissions, _ = types.SetValueFrom(ctx, types.StringType, res.Result.Permissions)
+	}
+	data.Attributes, _ = types.SetValueFrom(ctx, types.StringType, res.Result.Attrs)
+	data.Group = types.StringValue(res.Result.Group)
+	data.MemberGroup = types.StringValue(res.Result.Memberof)
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DelegationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DelegationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.DelegationModOptionalArgs{}
+
+	args := ipa.DelegationModArgs{
+		Aciname: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+
+	if !data.Permissions.Equal(state.Permissions) {
+		// FreeIPA falls back to the write permission when the attribute is removed from the configuration.
+		v := []string{"write"}
+		if len(data.Permissions.Elements()) > 0 {
+			v = setStringValues(data.Permissions)
+		}
+		optArgs.Permissions = &v
+		hasChange = true
+	}
+	if !data.Attributes.Equal(state.Attributes) {
+		v := setStringValues(data.Attributes)
+		optArgs.Attrs = &v
+		hasChange = true
+	}
+	if !data.Group.Equal(state.Group) {
+		optArgs.Group = data.Group.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.MemberGroup.Equal(state.MemberGroup) {
+		optArgs.Memberof = data.MemberGroup.ValueStringPointer()
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.DelegationMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa delegation rule: %s", err))
+				return
+			}
+		}
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DelegationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DelegationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa delegation rule %s", data.Name.ValueString()))
+	args := ipa.DelegationDelArgs{
+		Aciname: data.Name.ValueString(),
+	}
+	optArgs := ipa.DelegationDelOptionalArgs{}
+	_, err := r.client.DelegationDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Delegation rule %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *DelegationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPADelegation_full(t *testing.T) {
+	testGroup0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-helpdesk\"",
+	}
+	testGroup1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-staff\"",
+	}
+	testDelegation := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-delegation\"",
+		"attributes":   "[\"telephonenumber\"]",
+		"group":        "freeipa_group.group-0.name",
+		"member_group": "freeipa_group.group-1.name",
+	}
+	testDelegationModified := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-delegation\"",
+		"permissions":  "[\"write\"]",
+		"attributes":   "[\"telephonenumber\", \"mobile\"]",
+		"group":        "freeipa_group.group-1.name",
+		"member_group": "freeipa_group.group-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup0) + testAccFreeIPAGroup_resource(testGroup1) + testAccFreeIPADelegation_resource(testDelegation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "name", "testacc-delegation"),
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "group", "testacc-helpdesk"),
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "member_group", "testacc-staff"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup0) + testAccFreeIPAGroup_resource(testGroup1) + testAccFreeIPADelegation_resource(testDelegationModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "attributes.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "group", "testacc-staff"),
+					resource.TestCheckResourceAttr("freeipa_delegation.delegation-0", "member_group", "testacc-helpdesk"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup0) + testAccFreeIPAGroup_resource(testGroup1) + testAccFreeIPADelegation_resource(testDelegationModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HbacServiceDataSource{}
+var _ datasource.DataSourceWithConfigure = &HbacServiceDataSource{}
+
+func NewHbacServiceDataSource() datasource.DataSource {
+	return &HbacServiceDataSource{}
+}
+
+// HbacServiceDataSource defines the resource implementation.
+type HbacServiceDataSource struct {
+	client *ipa.Client
+}
+
+// HbacServiceDataSourceModel describes the data source data model.
+type HbacServiceDataSourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	MemberofHbacsvcgroup types.List   `tfsdk:"memberof_hbacsvcgroup"`
+}
+
+func (r *HbacServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_service"
+}
+
+func (r *HbacServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC service data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the HBAC service",
+				Computed:            true,
+			},
+			"memberof_hbacsvcgroup": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC service groups the HBAC service is member of",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *HbacServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HbacServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.HbacsvcShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.HbacsvcShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.HbacsvcShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa HBAC service %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.MemberofHbacsvcgroup != nil {
+		data.MemberofHbacsvcgroup, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberofHbacsvcgroup)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service %s", res.Result.Cn))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacService_simple(t *testing.T) {
+	testHbacSvc := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-hbacsvc\"",
+		"description": "\"An HBAC service\"",
+	}
+	testHbacSvcModified := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-hbacsvc\"",
+		"description": "\"A modified HBAC service\"",
+	}
+	testHbacSvcDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_hbac_service.hbacsvc-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.hbacsvc-1", "name", "testacc-hbacsvc"),
+					resource.TestCheckResourceAttr("freeipa_hbac_service.hbacsvc-1", "description", "An HBAC service"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvcModified) + testAccFreeIPAHbacService_datasource(testHbacSvcDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.hbacsvc-1", "description", "A modified HBAC service"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbacsvc-1", "name", "testacc-hbacsvc"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbacsvc-1", "description", "A modified HBAC service"),
+				),
+			},
+		},
+	})
+}
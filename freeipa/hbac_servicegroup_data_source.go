@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HbacServiceGroupDataSource{}
+var _ datasource.DataSourceWithConfigure = &HbacServiceGroupDataSource{}
+
+func NewHbacServiceGroupDataSource() datasource.DataSource {
+	return &HbacServiceGroupDataSource{}
+}
+
+// HbacServiceGroupDataSource defines the resource implementation.
+type HbacServiceGroupDataSource struct {
+	client *ipa.Client
+}
+
+// HbacServiceGroupDataSourceModel describes the data source data model.
+type HbacServiceGroupDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	MemberHbacsvc types.List   `tfsdk:"member_hbacsvc"`
+}
+
+func (r *HbacServiceGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_servicegroup"
+}
+
+func (r *HbacServiceGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC service group data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service group",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the HBAC service group",
+				Computed:            true,
+			},
+			"member_hbacsvc": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC services that are member of the HBAC service group",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *HbacServiceGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacServiceGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HbacServiceGroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.HbacsvcgroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.HbacsvcgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.HbacsvcgroupShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa HBAC service group %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.MemberHbacsvc != nil {
+		data.MemberHbacsvc, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberHbacsvc)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group %s", res.Result.Cn))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,430 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HbacServiceGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &HbacServiceGroupMembershipResource{}
+
+func NewHbacServiceGroupMembershipResource() resource.Resource {
+	return &HbacServiceGroupMembershipResource{}
+}
+
+// HbacServiceGroupMembershipResource defines the resource implementation.
+type HbacServiceGroupMembershipResource struct {
+	client *ipa.Client
+}
+
+// HbacServiceGroupMembershipResourceModel describes the resource data model.
+type HbacServiceGroupMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Service    types.String `tfsdk:"service"`
+	Services   types.List   `tfsdk:"services"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *HbacServiceGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_servicegroup_membership"
+}
+
+func (r *HbacServiceGroupMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("services"),
+		),
+	}
+}
+
+func (r *HbacServiceGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC service group membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "HBAC service to add as a member",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"services": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC services to add as a member",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple HBAC service group membership resources on the same HBAC service group. Mandatory for using services configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HbacServiceGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacServiceGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HbacServiceGroupMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.HbacsvcgroupAddMemberOptionalArgs{}
+
+	args := ipa.HbacsvcgroupAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	if !data.Service.IsNull() {
+		v := []string{data.Service.ValueString()}
+		optArgs.Hbacsvc = &v
+		id = fmt.Sprintf("%s/s/%s", encodeSlash(data.Name.ValueString()), data.Service.ValueString())
+	}
+	if !data.Services.IsNull() {
+		var v []string
+		for _, value := range data.Services.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Hbacsvc = &v
+		id = fmt.Sprintf("%s/ms/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+	_v, err := r.client.HbacsvcgroupAddMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa HBAC service group membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa HBAC service group membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HbacServiceGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HbacServiceGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svcgrpId, typeId, svcId, err := parseHbacServiceGroupMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_hbac_servicegroup_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HbacsvcgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.HbacsvcgroupShowArgs{
+		Cn: svcgrpId,
+	}
+
+	res, err := r.client.HbacsvcgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] HBAC service group not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa HBAC service group: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "s":
+		if res.Result.MemberHbacsvc == nil || !slices.Contains(*res.Result.MemberHbacsvc, svcId) {
+			tflog.Debug(ctx, "[DEBUG] HBAC service group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "ms":
+		if !data.Services.IsNull() {
+			var changedVals []string
+			for _, value := range data.Services.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group member services failed with error %s", err))
+				}
+				if res.Result.MemberHbacsvc != nil && slices.Contains(*res.Result.MemberHbacsvc, val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group member services %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.Services, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		} else {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HbacServiceGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HbacServiceGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.HbacsvcgroupAddMemberOptionalArgs{}
+
+	memberAddArgs := ipa.HbacsvcgroupAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.HbacsvcgroupRemoveMemberOptionalArgs{}
+
+	memberDelArgs := ipa.HbacsvcgroupRemoveMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Services.Equal(state.Services) {
+		var statearr, planarr, addedSvcs, deletedSvcs []string
+
+		for _, value := range state.Services.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Services.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedSvcs = append(addedSvcs, val)
+				memberAddOptArgs.Hbacsvc = &addedSvcs
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedSvcs = append(deletedSvcs, value)
+				memberDelOptArgs.Hbacsvc = &deletedSvcs
+				hasMemberDel = true
+			}
+		}
+
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.HbacsvcgroupAddMember(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa HBAC service group membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa HBAC service group membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa HBAC service group membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.HbacsvcgroupRemoveMember(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa HBAC service group membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa HBAC service group membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa HBAC service group membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HbacServiceGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HbacServiceGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	svcgrpId, typeId, svcId, err := parseHbacServiceGroupMembershipID(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_hbac_servicegroup_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.HbacsvcgroupRemoveMemberOptionalArgs{}
+
+	args := ipa.HbacsvcgroupRemoveMemberArgs{
+		Cn: svcgrpId,
+	}
+
+	switch typeId {
+	case "s":
+		v := []string{svcId}
+		optArgs.Hbacsvc = &v
+	case "ms":
+		if !data.Services.IsNull() {
+			var v []string
+			for _, value := range data.Services.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Hbacsvc = &v
+		}
+	}
+
+	_, err = r.client.HbacsvcgroupRemoveMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa HBAC service group membership: %s", err))
+		return
+	}
+}
+
+func (r *HbacServiceGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	svcgrpId, typeId, memberId, err := parseHbacServiceGroupMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.HbacsvcgroupShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.HbacsvcgroupShowArgs{
+		Cn: svcgrpId,
+	}
+
+	res, err := r.client.HbacsvcgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "HBAC service group not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa HBAC service group: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), svcgrpId)...)
+
+	switch typeId {
+	case "s":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), memberId)...)
+	case "ms":
+		// The imported membership manages all the services currently member of the group.
+		var services []string
+		if res.Result.MemberHbacsvc != nil {
+			services = *res.Result.MemberHbacsvc
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("services"), services)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseHbacServiceGroupMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine HBAC service group membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	service := idParts[2]
+
+	return name, _type, service, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacServiceGroupMembership_simple(t *testing.T) {
+	testHbacSvc := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-hbacsvc-1\"",
+	}
+	testHbacSvcGroup := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-hbacsvcgroup\"",
+	}
+	testHbacSvcGroupMembership := map[string]string{
+		"index":   "1",
+		"name":    "freeipa_hbac_servicegroup.hbacsvcgroup-1.name",
+		"service": "freeipa_hbac_service.hbacsvc-1.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "name", "testacc-hbacsvcgroup"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "service", "testacc-hbacsvc-1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAHbacServiceGroupMembership_multiple(t *testing.T) {
+	testHbacSvc1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-hbacsvc-1\"",
+	}
+	testHbacSvc2 := map[string]string{
+		"index": "2",
+		"name":  "\"testacc-hbacsvc-2\"",
+	}
+	testHbacSvcGroup := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-hbacsvcgroup\"",
+	}
+	testHbacSvcGroupMembership := map[string]string{
+		"index":      "1",
+		"name":       "freeipa_hbac_servicegroup.hbacsvcgroup-1.name",
+		"services":   "[freeipa_hbac_service.hbacsvc-1.name]",
+		"identifier": "\"multiplesvcs\"",
+	}
+	testHbacSvcGroupMembershipModified := map[string]string{
+		"index":      "1",
+		"name":       "freeipa_hbac_servicegroup.hbacsvcgroup-1.name",
+		"services":   "[freeipa_hbac_service.hbacsvc-1.name,freeipa_hbac_service.hbacsvc-2.name]",
+		"identifier": "\"multiplesvcs\"",
+	}
+	testHbacSvcGroupDS := map[string]string{
+		"index": "1",
+		"name":  "freeipa_hbac_servicegroup.hbacsvcgroup-1.name",
+	}
+	testHbacSvcDS := map[string]string{
+		"index": "2",
+		"name":  "freeipa_hbac_service.hbacsvc-2.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc1) + testAccFreeIPAHbacService_resource(testHbacSvc2) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "services.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "services.0", "testacc-hbacsvc-1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc1) + testAccFreeIPAHbacService_resource(testHbacSvc2) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "services.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "services.0", "testacc-hbacsvc-1"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup_membership.hbacsvcgroup-membership-1", "services.1", "testacc-hbacsvc-2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc1) + testAccFreeIPAHbacService_resource(testHbacSvc2) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembershipModified) + testAccFreeIPAHbacServiceGroup_datasource(testHbacSvcGroupDS) + testAccFreeIPAHbacService_datasource(testHbacSvcDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_hbac_servicegroup.hbacsvcgroup-1", "member_hbacsvc.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_servicegroup.hbacsvcgroup-1", "member_hbacsvc.0", "testacc-hbacsvc-1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_servicegroup.hbacsvcgroup-1", "member_hbacsvc.1", "testacc-hbacsvc-2"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbacsvc-2", "memberof_hbacsvcgroup.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_service.hbacsvc-2", "memberof_hbacsvcgroup.0", "testacc-hbacsvcgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacService_resource(testHbacSvc1) + testAccFreeIPAHbacService_resource(testHbacSvc2) + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup) + testAccFreeIPAHbacServiceGroupMembership_resource(testHbacSvcGroupMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HbacServiceGroupResource{}
+var _ resource.ResourceWithImportState = &HbacServiceGroupResource{}
+
+func NewHbacServiceGroupResource() resource.Resource {
+	return &HbacServiceGroupResource{}
+}
+
+// HbacServiceGroupResource defines the resource implementation.
+type HbacServiceGroupResource struct {
+	client *ipa.Client
+}
+
+// HbacServiceGroupResourceModel describes the resource data model.
+type HbacServiceGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *HbacServiceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_servicegroup"
+}
+
+func (r *HbacServiceGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *HbacServiceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC service group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "HBAC service group description",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *HbacServiceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacServiceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HbacServiceGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.HbacsvcgroupAddOptionalArgs{}
+
+	args := ipa.HbacsvcgroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	_, err := r.client.HbacsvcgroupAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa HBAC service group: %s", err))
+	}
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HbacServiceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HbacServiceGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.HbacsvcgroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.HbacsvcgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.HbacsvcgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] HBAC service group not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa HBAC service group: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa HBAC service group %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	data.Id = data.Name
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa HBAC service group %s", res.Result.Cn))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *HbacServiceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HbacServiceGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.HbacsvcgroupModOptionalArgs{}
+
+	args := ipa.HbacsvcgroupModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa HBAC service group %s from plan = %v", data.Name.ValueString(), data))
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+	}
+	_, err := r.client.HbacsvcgroupMod(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa HBAC service group: %s", err))
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HbacServiceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HbacServiceGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa HBAC service group Id %s", data.Id.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa HBAC service group Name %s", data.Name.ValueString()))
+	args := ipa.HbacsvcgroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	optArgs := ipa.HbacsvcgroupDelOptionalArgs{}
+	_, err := r.client.HbacsvcgroupDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] HBAC service group %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+
+}
+
+func (r *HbacServiceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAHbacServiceGroup_simple(t *testing.T) {
+	testHbacSvcGroup := map[string]string{
+		"index":       "1",
+		"name":        "\"testacc-hbacsvcgroup\"",
+		"description": "\"A set of HBAC services\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.hbacsvcgroup-1", "name", "testacc-hbacsvcgroup"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.hbacsvcgroup-1", "description", "A set of HBAC services"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacServiceGroup_resource(testHbacSvcGroup),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
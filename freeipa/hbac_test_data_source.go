@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HbacTestDataSource{}
+var _ datasource.DataSourceWithConfigure = &HbacTestDataSource{}
+
+func NewHbacTestDataSource() datasource.DataSource {
+	return &HbacTestDataSource{}
+}
+
+// HbacTestDataSource defines the data source implementation.
+type HbacTestDataSource struct {
+	client *ipa.Client
+}
+
+// HbacTestDataSourceModel describes the data source data model.
+type HbacTestDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	User            types.String `tfsdk:"user"`
+	TargetHost      types.String `tfsdk:"targethost"`
+	Service         types.String `tfsdk:"service"`
+	Rules           types.List   `tfsdk:"rules"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Disabled        types.Bool   `tfsdk:"disabled"`
+	AccessGranted   types.Bool   `tfsdk:"access_granted"`
+	MatchedRules    types.List   `tfsdk:"matched_rules"`
+	NotMatchedRules types.List   `tfsdk:"notmatched_rules"`
+}
+
+func (r *HbacTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_test"
+}
+
+func (r *HbacTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA HBAC test data source.\nSimulates the evaluation of the HBAC rules for a user accessing a service on a host, without any change to the rules.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user requesting the access",
+				Required:            true,
+			},
+			"targethost": schema.StringAttribute{
+				MarkdownDescription: "FQDN of the host the user is accessing",
+				Required:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service the user is accessing (e.g. `sshd`)",
+				Required:            true,
+			},
+			"rules": schema.ListAttribute{
+				MarkdownDescription: "List of HBAC rules to evaluate. When not set, all the enabled HBAC rules are evaluated.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Evaluate all the enabled HBAC rules in addition to the ones in `rules`",
+				Optional:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Evaluate all the disabled HBAC rules in addition to the ones in `rules`",
+				Optional:            true,
+			},
+			"access_granted": schema.BoolAttribute{
+				MarkdownDescription: "True if at least one of the evaluated rules grants the access",
+				Computed:            true,
+			},
+			"matched_rules": schema.ListAttribute{
+				MarkdownDescription: "List of the evaluated HBAC rules granting the access",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"notmatched_rules": schema.ListAttribute{
+				MarkdownDescription: "List of the evaluated HBAC rules not granting the access",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *HbacTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HbacTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HbacTestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.HbactestArgs{
+		User:       data.User.ValueString(),
+		Targethost: data.TargetHost.ValueString(),
+		Service:    data.Service.ValueString(),
+	}
+	optArgs := ipa.HbactestOptionalArgs{}
+
+	if !data.Rules.IsNull() {
+		v := listStringValues(data.Rules)
+		optArgs.Rules = &v
+	}
+	if !data.Enabled.IsNull() {
+		optArgs.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	if !data.Disabled.IsNull() {
+		optArgs.Disabled = data.Disabled.ValueBoolPointer()
+	}
+
+	res, err := r.client.Hbactest(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error running freeipa HBAC test: %s", err))
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error running freeipa HBAC test for user %s on %s", data.User.ValueString(), data.TargetHost.ValueString()))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Freeipa HBAC test result %v", res))
+
+	matched, notmatched := []string{}, []string{}
+	if res.Matched != nil {
+		matched = *res.Matched
+	}
+	if res.Notmatched != nil {
+		notmatched = *res.Notmatched
+	}
+	data.AccessGranted = types.BoolValue(res.Value)
+	data.MatchedRules, _ = types.ListValueFrom(ctx, types.StringType, matched)
+	data.NotMatchedRules, _ = types.ListValueFrom(ctx, types.StringType, notmatched)
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", data.User.ValueString(), data.TargetHost.ValueString(), data.Service.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
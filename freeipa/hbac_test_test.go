@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeIPAHbacTest_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testUser0 := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testUser1 := map[string]string{
+		"index":     "1",
+		"login":     "\"testacc-user-1\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User1\"",
+	}
+	testHbacPolicy := map[string]string{
+		"index":           "1",
+		"name":            "\"testacc-hbac-policy\"",
+		"description":     "\"A hbac policy for acceptance tests\"",
+		"hostcategory":    "\"all\"",
+		"servicecategory": "\"all\"",
+	}
+	testHbacPolicyDisabled := map[string]string{
+		"index":           "1",
+		"name":            "\"testacc-hbac-policy\"",
+		"description":     "\"A hbac policy for acceptance tests\"",
+		"enabled":         "false",
+		"hostcategory":    "\"all\"",
+		"servicecategory": "\"all\"",
+	}
+	testHbacUserMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_hbac_policy.hbacpolicy-1.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testHbacTestGranted := map[string]string{
+		"index":      "0",
+		"user":       "freeipa_user.user-0.name",
+		"targethost": "freeipa_host.host-0.name",
+		"service":    "\"sshd\"",
+		"rules":      "[freeipa_hbac_policy.hbacpolicy-1.name]",
+		"depends_on": "[freeipa_hbac_policy.hbacpolicy-1, freeipa_hbac_policy_user_membership.hbac-user-membership-1]",
+	}
+	testHbacTestDenied := map[string]string{
+		"index":      "1",
+		"user":       "freeipa_user.user-1.name",
+		"targethost": "freeipa_host.host-0.name",
+		"service":    "\"sshd\"",
+		"rules":      "[freeipa_hbac_policy.hbacpolicy-1.name]",
+		"depends_on": "[freeipa_hbac_policy.hbacpolicy-1, freeipa_hbac_policy_user_membership.hbac-user-membership-1]",
+	}
+	testHbacTestDisabled := map[string]string{
+		"index":      "0",
+		"user":       "freeipa_user.user-0.name",
+		"targethost": "freeipa_host.host-0.name",
+		"service":    "\"sshd\"",
+		"rules":      "[freeipa_hbac_policy.hbacpolicy-1.name]",
+		"depends_on": "[freeipa_hbac_policy.hbacpolicy-1, freeipa_hbac_policy_user_membership.hbac-user-membership-1]",
+		"disabled":   "true",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPAHbacPolicyUserMembership_resource(testHbacUserMembership) + testAccFreeIPAHbacTest_datasource(testHbacTestGranted) + testAccFreeIPAHbacTest_datasource(testHbacTestDenied),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "access_granted", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "matched_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "matched_rules.0", "testacc-hbac-policy"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "notmatched_rules.#", "0"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-1", "access_granted", "false"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-1", "matched_rules.#", "0"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-1", "notmatched_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-1", "notmatched_rules.0", "testacc-hbac-policy"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAHbacPolicy_resource(testHbacPolicyDisabled) + testAccFreeIPAHbacPolicyUserMembership_resource(testHbacUserMembership) + testAccFreeIPAHbacTest_datasource(testHbacTestDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "access_granted", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "matched_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.hbactest-0", "matched_rules.0", "testacc-hbac-policy"),
+				),
+			},
+		},
+	})
+}
@@ -789,3 +789,1225 @@ func testAccFreeIPAHbacPolicyServiceMembership_resource(dataset map[string]strin
 	tf_def += "}\n"
 	return tf_def
 }
+
+func testAccFreeIPAService_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service" "service-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["krb_auth_indicators"] != "" {
+		tf_def += fmt.Sprintf("  krb_auth_indicators = %s\n", dataset["krb_auth_indicators"])
+	}
+	if dataset["pac_type"] != "" {
+		tf_def += fmt.Sprintf("  pac_type = %s\n", dataset["pac_type"])
+	}
+	if dataset["krb_preauth"] != "" {
+		tf_def += fmt.Sprintf("  krb_preauth = %s\n", dataset["krb_preauth"])
+	}
+	if dataset["trusted_for_delegation"] != "" {
+		tf_def += fmt.Sprintf("  trusted_for_delegation = %s\n", dataset["trusted_for_delegation"])
+	}
+	if dataset["trusted_to_auth_as_delegate"] != "" {
+		tf_def += fmt.Sprintf("  trusted_to_auth_as_delegate = %s\n", dataset["trusted_to_auth_as_delegate"])
+	}
+	if dataset["managed_by_hosts"] != "" {
+		tf_def += fmt.Sprintf("  managed_by_hosts = %s\n", dataset["managed_by_hosts"])
+	}
+	if dataset["allowed_to_retrieve_keytab_users"] != "" {
+		tf_def += fmt.Sprintf("  allowed_to_retrieve_keytab_users = %s\n", dataset["allowed_to_retrieve_keytab_users"])
+	}
+	if dataset["allowed_to_retrieve_keytab_groups"] != "" {
+		tf_def += fmt.Sprintf("  allowed_to_retrieve_keytab_groups = %s\n", dataset["allowed_to_retrieve_keytab_groups"])
+	}
+	if dataset["allowed_to_retrieve_keytab_hosts"] != "" {
+		tf_def += fmt.Sprintf("  allowed_to_retrieve_keytab_hosts = %s\n", dataset["allowed_to_retrieve_keytab_hosts"])
+	}
+	if dataset["allowed_to_retrieve_keytab_hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  allowed_to_retrieve_keytab_hostgroups = %s\n", dataset["allowed_to_retrieve_keytab_hostgroups"])
+	}
+	if dataset["force"] != "" {
+		tf_def += fmt.Sprintf("  force = %s\n", dataset["force"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAService_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_service" "service-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAHostAllowRetrieveKeytab_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_host_allow_retrieve_keytab" "keytab-%s" {
+	  name  = %s
+	`, dataset["index"], dataset["name"])
+
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHostAllowCreateKeytab_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_host_allow_create_keytab" "keytab-%s" {
+	  name  = %s
+	`, dataset["index"], dataset["name"])
+
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAServiceAllowRetrieveKeytab_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service_allow_retrieve_keytab" "keytab-%s" {
+	  name  = %s
+	`, dataset["index"], dataset["name"])
+
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAServiceAllowCreateKeytab_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_service_allow_create_keytab" "keytab-%s" {
+	  name  = %s
+	`, dataset["index"], dataset["name"])
+
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacService_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_service" "hbacsvc-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacService_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_hbac_service" "hbacsvc-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAHbacServiceGroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_servicegroup" "hbacsvcgroup-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacServiceGroupMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_hbac_servicegroup_membership" "hbacsvcgroup-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["service"] != "" {
+		tf_def += fmt.Sprintf("  service = %s\n", dataset["service"])
+	}
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAHbacServiceGroup_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_hbac_servicegroup" "hbacsvcgroup-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAHbacTest_datasource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	data "freeipa_hbac_test" "hbactest-%s" {
+	  user       = %s
+	  targethost = %s
+	  service    = %s
+	`, dataset["index"], dataset["user"], dataset["targethost"], dataset["service"])
+	if dataset["rules"] != "" {
+		tf_def += fmt.Sprintf("  rules = %s\n", dataset["rules"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	if dataset["disabled"] != "" {
+		tf_def += fmt.Sprintf("  disabled = %s\n", dataset["disabled"])
+	}
+	if dataset["depends_on"] != "" {
+		tf_def += fmt.Sprintf("  depends_on = %s\n", dataset["depends_on"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPasswordPolicy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_password_policy" "pwpolicy-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["priority"] != "" {
+		tf_def += fmt.Sprintf("  priority = %s\n", dataset["priority"])
+	}
+	if dataset["max_lifetime"] != "" {
+		tf_def += fmt.Sprintf("  max_lifetime = %s\n", dataset["max_lifetime"])
+	}
+	if dataset["min_lifetime"] != "" {
+		tf_def += fmt.Sprintf("  min_lifetime = %s\n", dataset["min_lifetime"])
+	}
+	if dataset["history_size"] != "" {
+		tf_def += fmt.Sprintf("  history_size = %s\n", dataset["history_size"])
+	}
+	if dataset["min_classes"] != "" {
+		tf_def += fmt.Sprintf("  min_classes = %s\n", dataset["min_classes"])
+	}
+	if dataset["min_length"] != "" {
+		tf_def += fmt.Sprintf("  min_length = %s\n", dataset["min_length"])
+	}
+	if dataset["max_failures"] != "" {
+		tf_def += fmt.Sprintf("  max_failures = %s\n", dataset["max_failures"])
+	}
+	if dataset["failure_interval"] != "" {
+		tf_def += fmt.Sprintf("  failure_interval = %s\n", dataset["failure_interval"])
+	}
+	if dataset["lockout_time"] != "" {
+		tf_def += fmt.Sprintf("  lockout_time = %s\n", dataset["lockout_time"])
+	}
+	if dataset["grace_limit"] != "" {
+		tf_def += fmt.Sprintf("  grace_limit = %s\n", dataset["grace_limit"])
+	}
+	if dataset["max_repeat"] != "" {
+		tf_def += fmt.Sprintf("  max_repeat = %s\n", dataset["max_repeat"])
+	}
+	if dataset["max_sequence"] != "" {
+		tf_def += fmt.Sprintf("  max_sequence = %s\n", dataset["max_sequence"])
+	}
+	if dataset["dictionary_check"] != "" {
+		tf_def += fmt.Sprintf("  dictionary_check = %s\n", dataset["dictionary_check"])
+	}
+	if dataset["user_check"] != "" {
+		tf_def += fmt.Sprintf("  user_check = %s\n", dataset["user_check"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPasswordPolicy_datasource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	data "freeipa_password_policy" "pwpolicy-%s" {
+	`, dataset["index"])
+	if dataset["name"] != "" {
+		tf_def += fmt.Sprintf("  name = %s\n", dataset["name"])
+	}
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAKerberosTicketPolicy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_kerberos_ticket_policy" "krbtpolicy-%s" {
+	`, dataset["index"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["max_life"] != "" {
+		tf_def += fmt.Sprintf("  max_life = %s\n", dataset["max_life"])
+	}
+	if dataset["max_renew"] != "" {
+		tf_def += fmt.Sprintf("  max_renew = %s\n", dataset["max_renew"])
+	}
+	if dataset["otp_max_life"] != "" {
+		tf_def += fmt.Sprintf("  otp_max_life = %s\n", dataset["otp_max_life"])
+	}
+	if dataset["otp_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  otp_max_renew = %s\n", dataset["otp_max_renew"])
+	}
+	if dataset["radius_max_life"] != "" {
+		tf_def += fmt.Sprintf("  radius_max_life = %s\n", dataset["radius_max_life"])
+	}
+	if dataset["radius_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  radius_max_renew = %s\n", dataset["radius_max_renew"])
+	}
+	if dataset["pkinit_max_life"] != "" {
+		tf_def += fmt.Sprintf("  pkinit_max_life = %s\n", dataset["pkinit_max_life"])
+	}
+	if dataset["pkinit_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  pkinit_max_renew = %s\n", dataset["pkinit_max_renew"])
+	}
+	if dataset["hardened_max_life"] != "" {
+		tf_def += fmt.Sprintf("  hardened_max_life = %s\n", dataset["hardened_max_life"])
+	}
+	if dataset["hardened_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  hardened_max_renew = %s\n", dataset["hardened_max_renew"])
+	}
+	if dataset["idp_max_life"] != "" {
+		tf_def += fmt.Sprintf("  idp_max_life = %s\n", dataset["idp_max_life"])
+	}
+	if dataset["idp_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  idp_max_renew = %s\n", dataset["idp_max_renew"])
+	}
+	if dataset["passkey_max_life"] != "" {
+		tf_def += fmt.Sprintf("  passkey_max_life = %s\n", dataset["passkey_max_life"])
+	}
+	if dataset["passkey_max_renew"] != "" {
+		tf_def += fmt.Sprintf("  passkey_max_renew = %s\n", dataset["passkey_max_renew"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACertificate_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_certificate" "certificate-%s" {
+	  principal   = %s
+	  csr         = %s
+	`, dataset["index"], dataset["principal"], dataset["csr"])
+	if dataset["ca"] != "" {
+		tf_def += fmt.Sprintf("  ca = %s\n", dataset["ca"])
+	}
+	if dataset["profile_id"] != "" {
+		tf_def += fmt.Sprintf("  profile_id = %s\n", dataset["profile_id"])
+	}
+	if dataset["renewal_window"] != "" {
+		tf_def += fmt.Sprintf("  renewal_window = %s\n", dataset["renewal_window"])
+	}
+	if dataset["revocation_reason"] != "" {
+		tf_def += fmt.Sprintf("  revocation_reason = %s\n", dataset["revocation_reason"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACertProfile_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_certprofile" "certprofile-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["config"] != "" {
+		tf_def += fmt.Sprintf("  config = %s\n", dataset["config"])
+	}
+	if dataset["store"] != "" {
+		tf_def += fmt.Sprintf("  store = %s\n", dataset["store"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACa_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_ca" "ca-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["subject"] != "" {
+		tf_def += fmt.Sprintf("  subject = %s\n", dataset["subject"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaacl_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl" "caacl-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	if dataset["servicecategory"] != "" {
+		tf_def += fmt.Sprintf("  servicecategory = %s\n", dataset["servicecategory"])
+	}
+	if dataset["profilecategory"] != "" {
+		tf_def += fmt.Sprintf("  profilecategory = %s\n", dataset["profilecategory"])
+	}
+	if dataset["cacategory"] != "" {
+		tf_def += fmt.Sprintf("  cacategory = %s\n", dataset["cacategory"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclUserMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_user_membership" "caacl-user-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_host_membership" "caacl-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclServiceMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_service_membership" "caacl-service-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["service"] != "" {
+		tf_def += fmt.Sprintf("  service = %s\n", dataset["service"])
+	}
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclProfileMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_profile_membership" "caacl-profile-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["profile"] != "" {
+		tf_def += fmt.Sprintf("  profile = %s\n", dataset["profile"])
+	}
+	if dataset["profiles"] != "" {
+		tf_def += fmt.Sprintf("  profiles = %s\n", dataset["profiles"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPACaaclCaMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_caacl_ca_membership" "caacl-ca-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["ca"] != "" {
+		tf_def += fmt.Sprintf("  ca = %s\n", dataset["ca"])
+	}
+	if dataset["cas"] != "" {
+		tf_def += fmt.Sprintf("  cas = %s\n", dataset["cas"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdView_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idview" "idview-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["domain_resolution_order"] != "" {
+		tf_def += fmt.Sprintf("  domain_resolution_order = %s\n", dataset["domain_resolution_order"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdViewApply_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idview_apply" "idview-apply-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdOverrideUser_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idoverride_user" "idoverride-user-%s" {
+	  idview      = %s
+	  anchor      = %s
+	`, dataset["index"], dataset["idview"], dataset["anchor"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["login"] != "" {
+		tf_def += fmt.Sprintf("  login = %s\n", dataset["login"])
+	}
+	if dataset["uid_number"] != "" {
+		tf_def += fmt.Sprintf("  uid_number = %s\n", dataset["uid_number"])
+	}
+	if dataset["gid_number"] != "" {
+		tf_def += fmt.Sprintf("  gid_number = %s\n", dataset["gid_number"])
+	}
+	if dataset["gecos"] != "" {
+		tf_def += fmt.Sprintf("  gecos = %s\n", dataset["gecos"])
+	}
+	if dataset["home_directory"] != "" {
+		tf_def += fmt.Sprintf("  home_directory = %s\n", dataset["home_directory"])
+	}
+	if dataset["login_shell"] != "" {
+		tf_def += fmt.Sprintf("  login_shell = %s\n", dataset["login_shell"])
+	}
+	if dataset["ssh_public_key"] != "" {
+		tf_def += fmt.Sprintf("  ssh_public_key = %s\n", dataset["ssh_public_key"])
+	}
+	if dataset["user_certificates"] != "" {
+		tf_def += fmt.Sprintf("  user_certificates = %s\n", dataset["user_certificates"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdOverrideGroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idoverride_group" "idoverride-group-%s" {
+	  idview      = %s
+	  anchor      = %s
+	`, dataset["index"], dataset["idview"], dataset["anchor"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["name"] != "" {
+		tf_def += fmt.Sprintf("  name = %s\n", dataset["name"])
+	}
+	if dataset["gid_number"] != "" {
+		tf_def += fmt.Sprintf("  gid_number = %s\n", dataset["gid_number"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPermission_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_permission" "permission-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["rights"] != "" {
+		tf_def += fmt.Sprintf("  rights = %s\n", dataset["rights"])
+	}
+	if dataset["attributes"] != "" {
+		tf_def += fmt.Sprintf("  attributes = %s\n", dataset["attributes"])
+	}
+	if dataset["bind_type"] != "" {
+		tf_def += fmt.Sprintf("  bind_type = %s\n", dataset["bind_type"])
+	}
+	if dataset["type"] != "" {
+		tf_def += fmt.Sprintf("  type = %s\n", dataset["type"])
+	}
+	if dataset["subtree"] != "" {
+		tf_def += fmt.Sprintf("  subtree = %s\n", dataset["subtree"])
+	}
+	if dataset["target_filter"] != "" {
+		tf_def += fmt.Sprintf("  target_filter = %s\n", dataset["target_filter"])
+	}
+	if dataset["target"] != "" {
+		tf_def += fmt.Sprintf("  target = %s\n", dataset["target"])
+	}
+	if dataset["target_group"] != "" {
+		tf_def += fmt.Sprintf("  target_group = %s\n", dataset["target_group"])
+	}
+	if dataset["memberof"] != "" {
+		tf_def += fmt.Sprintf("  memberof = %s\n", dataset["memberof"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPermission_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_permission" "permission-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAPrivilege_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_privilege" "privilege-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPrivilege_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_privilege" "privilege-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAPrivilegePermissionMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_privilege_permission_membership" "privilege-permission-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["permission"] != "" {
+		tf_def += fmt.Sprintf("  permission = %s\n", dataset["permission"])
+	}
+	if dataset["permissions"] != "" {
+		tf_def += fmt.Sprintf("  permissions = %s\n", dataset["permissions"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARole_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role" "role-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARole_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_role" "role-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPARolePrivilegeMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role_privilege_membership" "role-privilege-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["privilege"] != "" {
+		tf_def += fmt.Sprintf("  privilege = %s\n", dataset["privilege"])
+	}
+	if dataset["privileges"] != "" {
+		tf_def += fmt.Sprintf("  privileges = %s\n", dataset["privileges"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARoleMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_role_membership" "role-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["service"] != "" {
+		tf_def += fmt.Sprintf("  service = %s\n", dataset["service"])
+	}
+	if dataset["services"] != "" {
+		tf_def += fmt.Sprintf("  services = %s\n", dataset["services"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelfService_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selfservice" "selfservice-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["permissions"] != "" {
+		tf_def += fmt.Sprintf("  permissions = %s\n", dataset["permissions"])
+	}
+	if dataset["attributes"] != "" {
+		tf_def += fmt.Sprintf("  attributes = %s\n", dataset["attributes"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPADelegation_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_delegation" "delegation-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["permissions"] != "" {
+		tf_def += fmt.Sprintf("  permissions = %s\n", dataset["permissions"])
+	}
+	if dataset["attributes"] != "" {
+		tf_def += fmt.Sprintf("  attributes = %s\n", dataset["attributes"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["member_group"] != "" {
+		tf_def += fmt.Sprintf("  member_group = %s\n", dataset["member_group"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroup_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup" "netgroup-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["nisdomain"] != "" {
+		tf_def += fmt.Sprintf("  nisdomain = %s\n", dataset["nisdomain"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	if dataset["external_hosts"] != "" {
+		tf_def += fmt.Sprintf("  external_hosts = %s\n", dataset["external_hosts"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPANetgroupMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_netgroup_membership" "netgroup-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["netgroup"] != "" {
+		tf_def += fmt.Sprintf("  netgroup = %s\n", dataset["netgroup"])
+	}
+	if dataset["netgroups"] != "" {
+		tf_def += fmt.Sprintf("  netgroups = %s\n", dataset["netgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelinuxUsermap_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap" "selinux-usermap-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["selinuxuser"] != "" {
+		tf_def += fmt.Sprintf("  selinuxuser = %s\n", dataset["selinuxuser"])
+	}
+	if dataset["hbac_rule"] != "" {
+		tf_def += fmt.Sprintf("  hbac_rule = %s\n", dataset["hbac_rule"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["enabled"] != "" {
+		tf_def += fmt.Sprintf("  enabled = %s\n", dataset["enabled"])
+	}
+	if dataset["usercategory"] != "" {
+		tf_def += fmt.Sprintf("  usercategory = %s\n", dataset["usercategory"])
+	}
+	if dataset["hostcategory"] != "" {
+		tf_def += fmt.Sprintf("  hostcategory = %s\n", dataset["hostcategory"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelinuxUsermapUserMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap_user_membership" "selinux-usermap-user-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["users"] != "" {
+		tf_def += fmt.Sprintf("  users = %s\n", dataset["users"])
+	}
+	if dataset["group"] != "" {
+		tf_def += fmt.Sprintf("  group = %s\n", dataset["group"])
+	}
+	if dataset["groups"] != "" {
+		tf_def += fmt.Sprintf("  groups = %s\n", dataset["groups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPASelinuxUsermapHostMembership_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_selinux_usermap_host_membership" "selinux-usermap-host-membership-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	if dataset["host"] != "" {
+		tf_def += fmt.Sprintf("  host = %s\n", dataset["host"])
+	}
+	if dataset["hosts"] != "" {
+		tf_def += fmt.Sprintf("  hosts = %s\n", dataset["hosts"])
+	}
+	if dataset["hostgroup"] != "" {
+		tf_def += fmt.Sprintf("  hostgroup = %s\n", dataset["hostgroup"])
+	}
+	if dataset["hostgroups"] != "" {
+		tf_def += fmt.Sprintf("  hostgroups = %s\n", dataset["hostgroups"])
+	}
+	if dataset["identifier"] != "" {
+		tf_def += fmt.Sprintf("  identifier = %s\n", dataset["identifier"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAAutomountLocation_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_automount_location" "automount-location-%s" {
+	  name        = %s
+	`, dataset["index"], dataset["name"])
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAAutomountLocation_datasource(dataset map[string]string) string {
+	return fmt.Sprintf(`
+	data "freeipa_automount_location" "automount-location-%s" {
+		name = %s
+	}
+	`, dataset["index"], dataset["name"])
+}
+
+func testAccFreeIPAAutomountMap_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_automount_map" "automount-map-%s" {
+	  location    = %s
+	  name        = %s
+	`, dataset["index"], dataset["location"], dataset["name"])
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["mount"] != "" {
+		tf_def += fmt.Sprintf("  mount = %s\n", dataset["mount"])
+	}
+	if dataset["parent_map"] != "" {
+		tf_def += fmt.Sprintf("  parent_map = %s\n", dataset["parent_map"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAAutomountKey_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_automount_key" "automount-key-%s" {
+	  location    = %s
+	  map         = %s
+	  key         = %s
+	  information = %s
+	`, dataset["index"], dataset["location"], dataset["map"], dataset["key"], dataset["information"])
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAOtpToken_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_otp_token" "otp-token-%s" {
+	`, dataset["index"])
+	if dataset["unique_id"] != "" {
+		tf_def += fmt.Sprintf("  unique_id = %s\n", dataset["unique_id"])
+	}
+	if dataset["type"] != "" {
+		tf_def += fmt.Sprintf("  type = %s\n", dataset["type"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["owner"] != "" {
+		tf_def += fmt.Sprintf("  owner = %s\n", dataset["owner"])
+	}
+	if dataset["managers"] != "" {
+		tf_def += fmt.Sprintf("  managers = %s\n", dataset["managers"])
+	}
+	if dataset["disabled"] != "" {
+		tf_def += fmt.Sprintf("  disabled = %s\n", dataset["disabled"])
+	}
+	if dataset["not_before"] != "" {
+		tf_def += fmt.Sprintf("  not_before = %s\n", dataset["not_before"])
+	}
+	if dataset["not_after"] != "" {
+		tf_def += fmt.Sprintf("  not_after = %s\n", dataset["not_after"])
+	}
+	if dataset["algorithm"] != "" {
+		tf_def += fmt.Sprintf("  algorithm = %s\n", dataset["algorithm"])
+	}
+	if dataset["digits"] != "" {
+		tf_def += fmt.Sprintf("  digits = %s\n", dataset["digits"])
+	}
+	if dataset["interval"] != "" {
+		tf_def += fmt.Sprintf("  interval = %s\n", dataset["interval"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAOtpConfig_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_otp_config" "otp-config-%s" {
+	`, dataset["index"])
+	if dataset["totp_auth_window"] != "" {
+		tf_def += fmt.Sprintf("  totp_auth_window = %s\n", dataset["totp_auth_window"])
+	}
+	if dataset["totp_sync_window"] != "" {
+		tf_def += fmt.Sprintf("  totp_sync_window = %s\n", dataset["totp_sync_window"])
+	}
+	if dataset["hotp_auth_window"] != "" {
+		tf_def += fmt.Sprintf("  hotp_auth_window = %s\n", dataset["hotp_auth_window"])
+	}
+	if dataset["hotp_sync_window"] != "" {
+		tf_def += fmt.Sprintf("  hotp_sync_window = %s\n", dataset["hotp_sync_window"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPARadiusProxy_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_radius_proxy" "radius-proxy-%s" {
+	`, dataset["index"])
+	if dataset["name"] != "" {
+		tf_def += fmt.Sprintf("  name = %s\n", dataset["name"])
+	}
+	if dataset["description"] != "" {
+		tf_def += fmt.Sprintf("  description = %s\n", dataset["description"])
+	}
+	if dataset["server"] != "" {
+		tf_def += fmt.Sprintf("  server = %s\n", dataset["server"])
+	}
+	if dataset["secret"] != "" {
+		tf_def += fmt.Sprintf("  secret = %s\n", dataset["secret"])
+	}
+	if dataset["timeout"] != "" {
+		tf_def += fmt.Sprintf("  timeout = %s\n", dataset["timeout"])
+	}
+	if dataset["retries"] != "" {
+		tf_def += fmt.Sprintf("  retries = %s\n", dataset["retries"])
+	}
+	if dataset["user_attribute"] != "" {
+		tf_def += fmt.Sprintf("  user_attribute = %s\n", dataset["user_attribute"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAIdp_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_idp" "idp-%s" {
+	`, dataset["index"])
+	if dataset["name"] != "" {
+		tf_def += fmt.Sprintf("  name = %s\n", dataset["name"])
+	}
+	if dataset["provider_type"] != "" {
+		tf_def += fmt.Sprintf("  provider_type = %s\n", dataset["provider_type"])
+	}
+	if dataset["organization"] != "" {
+		tf_def += fmt.Sprintf("  organization = %s\n", dataset["organization"])
+	}
+	if dataset["base_url"] != "" {
+		tf_def += fmt.Sprintf("  base_url = %s\n", dataset["base_url"])
+	}
+	if dataset["auth_uri"] != "" {
+		tf_def += fmt.Sprintf("  auth_uri = %s\n", dataset["auth_uri"])
+	}
+	if dataset["dev_auth_uri"] != "" {
+		tf_def += fmt.Sprintf("  dev_auth_uri = %s\n", dataset["dev_auth_uri"])
+	}
+	if dataset["token_uri"] != "" {
+		tf_def += fmt.Sprintf("  token_uri = %s\n", dataset["token_uri"])
+	}
+	if dataset["userinfo_uri"] != "" {
+		tf_def += fmt.Sprintf("  userinfo_uri = %s\n", dataset["userinfo_uri"])
+	}
+	if dataset["keys_uri"] != "" {
+		tf_def += fmt.Sprintf("  keys_uri = %s\n", dataset["keys_uri"])
+	}
+	if dataset["issuer_url"] != "" {
+		tf_def += fmt.Sprintf("  issuer_url = %s\n", dataset["issuer_url"])
+	}
+	if dataset["client_id"] != "" {
+		tf_def += fmt.Sprintf("  client_id = %s\n", dataset["client_id"])
+	}
+	if dataset["secret"] != "" {
+		tf_def += fmt.Sprintf("  secret = %s\n", dataset["secret"])
+	}
+	if dataset["scope"] != "" {
+		tf_def += fmt.Sprintf("  scope = %s\n", dataset["scope"])
+	}
+	if dataset["idp_user_id"] != "" {
+		tf_def += fmt.Sprintf("  idp_user_id = %s\n", dataset["idp_user_id"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAPasskeyConfig_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_passkey_config" "passkey-config-%s" {
+	`, dataset["index"])
+	if dataset["require_user_verification"] != "" {
+		tf_def += fmt.Sprintf("  require_user_verification = %s\n", dataset["require_user_verification"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
+
+func testAccFreeIPAUserPasskey_resource(dataset map[string]string) string {
+	tf_def := fmt.Sprintf(`
+	resource "freeipa_user_passkey" "user-passkey-%s" {
+	`, dataset["index"])
+	if dataset["user"] != "" {
+		tf_def += fmt.Sprintf("  user = %s\n", dataset["user"])
+	}
+	if dataset["passkeys"] != "" {
+		tf_def += fmt.Sprintf("  passkeys = %s\n", dataset["passkeys"])
+	}
+	tf_def += "}\n"
+	return tf_def
+}
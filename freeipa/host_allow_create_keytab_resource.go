@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+func NewHostAllowCreateKeytabResource() resource.Resource {
+	return &KeytabPermissionResource{
+		object:          "host",
+		operation:       "create",
+		nameDescription: "Host fully qualified name",
+		allow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.HostAllowCreateKeytab(&ipa.HostAllowCreateKeytabArgs{Fqdn: name}, &ipa.HostAllowCreateKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		disallow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.HostDisallowCreateKeytab(&ipa.HostDisallowCreateKeytabArgs{Fqdn: name}, &ipa.HostDisallowCreateKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		show: showHostKeytabMembers,
+	}
+}
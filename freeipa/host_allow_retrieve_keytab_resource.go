@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+func NewHostAllowRetrieveKeytabResource() resource.Resource {
+	return &KeytabPermissionResource{
+		object:          "host",
+		operation:       "retrieve",
+		nameDescription: "Host fully qualified name",
+		allow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.HostAllowRetrieveKeytab(&ipa.HostAllowRetrieveKeytabArgs{Fqdn: name}, &ipa.HostAllowRetrieveKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		disallow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.HostDisallowRetrieveKeytab(&ipa.HostDisallowRetrieveKeytabArgs{Fqdn: name}, &ipa.HostDisallowRetrieveKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		show: showHostKeytabMembers,
+	}
+}
@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdOverrideGroupResource{}
+var _ resource.ResourceWithImportState = &IdOverrideGroupResource{}
+
+func NewIdOverrideGroupResource() resource.Resource {
+	return &IdOverrideGroupResource{}
+}
+
+// IdOverrideGroupResource defines the resource implementation.
+type IdOverrideGroupResource struct {
+	client *ipa.Client
+}
+
+// IdOverrideGroupResourceModel describes the resource data model.
+type IdOverrideGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	IdView      types.String `tfsdk:"idview"`
+	Anchor      types.String `tfsdk:"anchor"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	GidNumber   types.Int64  `tfsdk:"gid_number"`
+}
+
+func (r *IdOverrideGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idoverride_group"
+}
+
+func (r *IdOverrideGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *IdOverrideGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA group ID override resource.\nOverrides the POSIX attributes of a FreeIPA group or of a trusted domain group in an ID view.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idview": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view the override belongs to (`Default Trust View` for the overrides applied to all the hosts)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor": schema.StringAttribute{
+				MarkdownDescription: "Group to override, either a FreeIPA group name or a trusted domain group (e.g. `admins@ad.example.com`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "ID override description",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Overridden group name",
+				Optional:            true,
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden group ID number",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *IdOverrideGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdOverrideGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverridegroupAddArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverridegroupAddOptionalArgs{
+		Gidnumber: intPointer(data.GidNumber),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Name.IsNull() {
+		optArgs.Cn = data.Name.ValueStringPointer()
+	}
+
+	_, err := r.client.IdoverridegroupAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa group ID override: %s", err))
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.IdView.ValueString()), data.Anchor.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.IdoverridegroupShowArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverridegroupShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.IdoverridegroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Group ID override not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa group ID override: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa group ID override %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa group ID override %s", data.Id.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Cn != nil && !data.Name.IsNull() {
+		data.Name = types.StringValue(*res.Result.Cn)
+	}
+	if res.Result.Gidnumber != nil && !data.GidNumber.IsNull() {
+		data.GidNumber = int64Value(res.Result.Gidnumber)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdOverrideGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdOverrideGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverridegroupModArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverridegroupModOptionalArgs{}
+
+	var hasChange = false
+	empty := ""
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = &empty
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Name.Equal(state.Name) {
+		optArgs.Cn = &empty
+		if !data.Name.IsNull() {
+			optArgs.Cn = data.Name.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.GidNumber.Equal(state.GidNumber) {
+		// A null value removes the overridden number.
+		if data.GidNumber.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "gidnumber=")
+		} else {
+			optArgs.Gidnumber = intPointer(data.GidNumber)
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.IdoverridegroupMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa group ID override: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdOverrideGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa group ID override %s", data.Id.ValueString()))
+	args := ipa.IdoverridegroupDelArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: []string{data.Anchor.ValueString()},
+	}
+	_, err := r.client.IdoverridegroupDel(&args, &ipa.IdoverridegroupDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Group ID override %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *IdOverrideGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idview, anchor, err := parseIdOverrideID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idview"), idview)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("anchor"), anchor)...)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdOverrideGroup_full(t *testing.T) {
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group\"",
+	}
+	testIdView := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testIdOverride := map[string]string{
+		"index":      "0",
+		"idview":     "freeipa_idview.idview-0.name",
+		"anchor":     "freeipa_group.group-0.name",
+		"gid_number": "20001",
+	}
+	testIdOverrideModified := map[string]string{
+		"index":       "0",
+		"idview":      "freeipa_idview.idview-0.name",
+		"anchor":      "freeipa_group.group-0.name",
+		"description": "\"A group override for acceptance tests\"",
+		"name":        "\"testacc-override\"",
+		"gid_number":  "20002",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideGroup_resource(testIdOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "idview", "testacc-idview"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "anchor", "testacc-group"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "gid_number", "20001"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideGroup_resource(testIdOverrideModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "description", "A group override for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "name", "testacc-override"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_group.idoverride-group-0", "gid_number", "20002"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideGroup_resource(testIdOverrideModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
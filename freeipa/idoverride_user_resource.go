@@ -0,0 +1,422 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdOverrideUserResource{}
+var _ resource.ResourceWithImportState = &IdOverrideUserResource{}
+
+func NewIdOverrideUserResource() resource.Resource {
+	return &IdOverrideUserResource{}
+}
+
+// IdOverrideUserResource defines the resource implementation.
+type IdOverrideUserResource struct {
+	client *ipa.Client
+}
+
+// IdOverrideUserResourceModel describes the resource data model.
+type IdOverrideUserResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	IdView        types.String `tfsdk:"idview"`
+	Anchor        types.String `tfsdk:"anchor"`
+	Description   types.String `tfsdk:"description"`
+	Login         types.String `tfsdk:"login"`
+	UidNumber     types.Int64  `tfsdk:"uid_number"`
+	GidNumber     types.Int64  `tfsdk:"gid_number"`
+	Gecos         types.String `tfsdk:"gecos"`
+	HomeDirectory types.String `tfsdk:"home_directory"`
+	LoginShell    types.String `tfsdk:"login_shell"`
+	SshPublicKeys types.List   `tfsdk:"ssh_public_key"`
+	UserCerts     types.Set    `tfsdk:"user_certificates"`
+}
+
+func (r *IdOverrideUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idoverride_user"
+}
+
+func (r *IdOverrideUserResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *IdOverrideUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA user ID override resource.\nOverrides the POSIX attributes of a FreeIPA user or of a trusted domain user in an ID view.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idview": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view the override belongs to (`Default Trust View` for the overrides applied to all the hosts)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"anchor": schema.StringAttribute{
+				MarkdownDescription: "User to override, either a FreeIPA user login or a trusted domain user (e.g. `user@ad.example.com`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "ID override description",
+				Optional:            true,
+			},
+			"login": schema.StringAttribute{
+				MarkdownDescription: "Overridden login",
+				Optional:            true,
+			},
+			"uid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden user ID number",
+				Optional:            true,
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "Overridden group ID number",
+				Optional:            true,
+			},
+			"gecos": schema.StringAttribute{
+				MarkdownDescription: "Overridden GECOS",
+				Optional:            true,
+			},
+			"home_directory": schema.StringAttribute{
+				MarkdownDescription: "Overridden home directory",
+				Optional:            true,
+			},
+			"login_shell": schema.StringAttribute{
+				MarkdownDescription: "Overridden login shell",
+				Optional:            true,
+			},
+			"ssh_public_key": schema.ListAttribute{
+				MarkdownDescription: "List of SSH public keys",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"user_certificates": schema.SetAttribute{
+				MarkdownDescription: "List of Base-64 encoded user certificates",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *IdOverrideUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdOverrideUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverrideuserAddArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverrideuserAddOptionalArgs{
+		Uidnumber: intPointer(data.UidNumber),
+		Gidnumber: intPointer(data.GidNumber),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Login.IsNull() {
+		optArgs.UID = data.Login.ValueStringPointer()
+	}
+	if !data.Gecos.IsNull() {
+		optArgs.Gecos = data.Gecos.ValueStringPointer()
+	}
+	if !data.HomeDirectory.IsNull() {
+		optArgs.Homedirectory = data.HomeDirectory.ValueStringPointer()
+	}
+	if !data.LoginShell.IsNull() {
+		optArgs.Loginshell = data.LoginShell.ValueStringPointer()
+	}
+	if len(data.SshPublicKeys.Elements()) > 0 {
+		v := listStringValues(data.SshPublicKeys)
+		optArgs.Ipasshpubkey = &v
+	}
+	if len(data.UserCerts.Elements()) > 0 {
+		var v []interface{}
+		for _, value := range data.UserCerts.Elements() {
+			v = append(v, value.(types.String).ValueString())
+		}
+		optArgs.Usercertificate = &v
+	}
+
+	_, err := r.client.IdoverrideuserAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa user ID override: %s", err))
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s", encodeSlash(data.IdView.ValueString()), data.Anchor.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.IdoverrideuserShowArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverrideuserShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.IdoverrideuserShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] User ID override not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa user ID override: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa user ID override %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa user ID override %s", data.Id.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.UID != nil && !data.Login.IsNull() {
+		data.Login = types.StringValue(*res.Result.UID)
+	}
+	if res.Result.Uidnumber != nil && !data.UidNumber.IsNull() {
+		data.UidNumber = int64Value(res.Result.Uidnumber)
+	}
+	if res.Result.Gidnumber != nil && !data.GidNumber.IsNull() {
+		data.GidNumber = int64Value(res.Result.Gidnumber)
+	}
+	if res.Result.Gecos != nil && !data.Gecos.IsNull() {
+		data.Gecos = types.StringValue(*res.Result.Gecos)
+	}
+	if res.Result.Homedirectory != nil && !data.HomeDirectory.IsNull() {
+		data.HomeDirectory = types.StringValue(*res.Result.Homedirectory)
+	}
+	if res.Result.Loginshell != nil && !data.LoginShell.IsNull() {
+		data.LoginShell = types.StringValue(*res.Result.Loginshell)
+	}
+	if res.Result.Ipasshpubkey != nil && !data.SshPublicKeys.IsNull() {
+		data.SshPublicKeys, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipasshpubkey)
+	}
+	if res.Result.Usercertificate != nil && !data.UserCerts.IsNull() {
+		var resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			str := v.([]interface{})[0].(map[string]interface{})["__base64__"]
+			resVals = append(resVals, str.(string))
+		}
+		data.UserCerts, _ = types.SetValueFrom(ctx, types.StringType, resVals)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdOverrideUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdOverrideUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdoverrideuserModArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: data.Anchor.ValueString(),
+	}
+	optArgs := ipa.IdoverrideuserModOptionalArgs{}
+
+	var hasChange = false
+	empty := ""
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = &empty
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Login.Equal(state.Login) {
+		optArgs.UID = &empty
+		if !data.Login.IsNull() {
+			optArgs.UID = data.Login.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.UidNumber.Equal(state.UidNumber) {
+		// A null value removes the overridden number.
+		if data.UidNumber.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "uidnumber=")
+		} else {
+			optArgs.Uidnumber = intPointer(data.UidNumber)
+		}
+		hasChange = true
+	}
+	if !data.GidNumber.Equal(state.GidNumber) {
+		if data.GidNumber.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "gidnumber=")
+		} else {
+			optArgs.Gidnumber = intPointer(data.GidNumber)
+		}
+		hasChange = true
+	}
+	if !data.Gecos.Equal(state.Gecos) {
+		optArgs.Gecos = &empty
+		if !data.Gecos.IsNull() {
+			optArgs.Gecos = data.Gecos.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HomeDirectory.Equal(state.HomeDirectory) {
+		optArgs.Homedirectory = &empty
+		if !data.HomeDirectory.IsNull() {
+			optArgs.Homedirectory = data.HomeDirectory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.LoginShell.Equal(state.LoginShell) {
+		optArgs.Loginshell = &empty
+		if !data.LoginShell.IsNull() {
+			optArgs.Loginshell = data.LoginShell.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.SshPublicKeys.Equal(state.SshPublicKeys) {
+		v := listStringValues(data.SshPublicKeys)
+		optArgs.Ipasshpubkey = &v
+		hasChange = true
+	}
+	if !data.UserCerts.Equal(state.UserCerts) {
+		v := []interface{}{}
+		for _, value := range data.UserCerts.Elements() {
+			v = append(v, value.(types.String).ValueString())
+		}
+		optArgs.Usercertificate = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.IdoverrideuserMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa user ID override: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdOverrideUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdOverrideUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa user ID override %s", data.Id.ValueString()))
+	args := ipa.IdoverrideuserDelArgs{
+		Idviewcn:      data.IdView.ValueString(),
+		Ipaanchoruuid: []string{data.Anchor.ValueString()},
+	}
+	_, err := r.client.IdoverrideuserDel(&args, &ipa.IdoverrideuserDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] User ID override %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *IdOverrideUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idview, anchor, err := parseIdOverrideID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("idview"), idview)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("anchor"), anchor)...)
+}
+
+// parseIdOverrideID returns the ID view and the anchor of an ID override from its ID.
+func parseIdOverrideID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("unable to determine ID override ID %s", id)
+	}
+
+	return decodeSlash(idParts[0]), idParts[1], nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdOverrideUser_full(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testIdView := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testIdOverride := map[string]string{
+		"index":       "0",
+		"idview":      "freeipa_idview.idview-0.name",
+		"anchor":      "freeipa_user.user-0.name",
+		"uid_number":  "20001",
+		"login_shell": "\"/bin/zsh\"",
+	}
+	testIdOverrideModified := map[string]string{
+		"index":          "0",
+		"idview":         "freeipa_idview.idview-0.name",
+		"anchor":         "freeipa_user.user-0.name",
+		"description":    "\"A user override for acceptance tests\"",
+		"login":          "\"testacc-override\"",
+		"gid_number":     "20001",
+		"gecos":          "\"Test Override\"",
+		"home_directory": "\"/srv/home/testacc-override\"",
+		"login_shell":    "\"/bin/bash\"",
+		"ssh_public_key": "[\"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJMSXDyPzjsP6oqPFlDx0u9Ov4utGVRXTw9XR3F0cb5y testacc\"]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideUser_resource(testIdOverride),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "idview", "testacc-idview"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "anchor", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "uid_number", "20001"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login_shell", "/bin/zsh"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideUser_resource(testIdOverrideModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "description", "A user override for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login", "testacc-override"),
+					resource.TestCheckNoResourceAttr("freeipa_idoverride_user.idoverride-user-0", "uid_number"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "gid_number", "20001"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "gecos", "Test Override"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "home_directory", "/srv/home/testacc-override"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "login_shell", "/bin/bash"),
+					resource.TestCheckResourceAttr("freeipa_idoverride_user.idoverride-user-0", "ssh_public_key.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdOverrideUser_resource(testIdOverrideModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,406 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdpResource{}
+var _ resource.ResourceWithImportState = &IdpResource{}
+
+func NewIdpResource() resource.Resource {
+	return &IdpResource{}
+}
+
+// IdpResource defines the resource implementation.
+type IdpResource struct {
+	client *ipa.Client
+}
+
+// IdpResourceModel describes the resource data model.
+type IdpResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	Organization types.String `tfsdk:"organization"`
+	BaseUrl      types.String `tfsdk:"base_url"`
+	AuthUri      types.String `tfsdk:"auth_uri"`
+	DevAuthUri   types.String `tfsdk:"dev_auth_uri"`
+	TokenUri     types.String `tfsdk:"token_uri"`
+	UserInfoUri  types.String `tfsdk:"userinfo_uri"`
+	KeysUri      types.String `tfsdk:"keys_uri"`
+	IssuerUrl    types.String `tfsdk:"issuer_url"`
+	ClientId     types.String `tfsdk:"client_id"`
+	Secret       types.String `tfsdk:"secret"`
+	Scope        types.String `tfsdk:"scope"`
+	IdpUserId    types.String `tfsdk:"idp_user_id"`
+}
+
+func (r *IdpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idp"
+}
+
+// idpTemplateRequiresReplace replaces the IdP when its template changes. FreeIPA does not return the template,
+// a template set in the configuration of an imported IdP is therefore only recorded in the state.
+func idpTemplateRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *IdpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA external identity provider (IdP) resource.\nThe endpoints are computed by FreeIPA when `provider_type` is set, they must be set for a custom IdP. The users reference the IdP by name with the `external_idp_config` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the identity provider",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Template of a well-known identity provider used to compute the endpoints (`keycloak`, `okta`, `microsoft` for Azure AD, `google` or `github`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("keycloak", "okta", "microsoft", "google", "github"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(idpTemplateRequiresReplace, "Changing the IdP template requires a replacement", "Changing the IdP template requires a replacement"),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Organization of the identity provider template, the realm for Keycloak or the tenant ID for Azure AD",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("provider_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(idpTemplateRequiresReplace, "Changing the IdP template requires a replacement", "Changing the IdP template requires a replacement"),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the identity provider template, for Keycloak and Okta (ex: `keycloak.example.lan:8443`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("provider_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(idpTemplateRequiresReplace, "Changing the IdP template requires a replacement", "Changing the IdP template requires a replacement"),
+				},
+			},
+			"auth_uri": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 authorization endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dev_auth_uri": schema.StringAttribute{
+				MarkdownDescription: "Device authorization endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_uri": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"userinfo_uri": schema.StringAttribute{
+				MarkdownDescription: "User information endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keys_uri": schema.StringAttribute{
+				MarkdownDescription: "JWKS endpoint",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "Issuer URL of the identity provider",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client identifier registered at the identity provider",
+				Required:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 client secret",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 scope, multiple scopes separated by space",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idp_user_id": schema.StringAttribute{
+				MarkdownDescription: "Attribute of the identity provider identifying the user",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IdpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdpAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipaidpclientid: data.ClientId.ValueString(),
+	}
+	optArgs := ipa.IdpAddOptionalArgs{
+		Ipaidpprovider:         data.ProviderType.ValueStringPointer(),
+		Ipaidporg:              data.Organization.ValueStringPointer(),
+		Ipaidpbaseurl:          data.BaseUrl.ValueStringPointer(),
+		Ipaidpauthendpoint:     data.AuthUri.ValueStringPointer(),
+		Ipaidpdevauthendpoint:  data.DevAuthUri.ValueStringPointer(),
+		Ipaidptokenendpoint:    data.TokenUri.ValueStringPointer(),
+		Ipaidpuserinfoendpoint: data.UserInfoUri.ValueStringPointer(),
+		Ipaidpkeysendpoint:     data.KeysUri.ValueStringPointer(),
+		Ipaidpissuerurl:        data.IssuerUrl.ValueStringPointer(),
+		Ipaidpclientsecret:     data.Secret.ValueStringPointer(),
+		Ipaidpscope:            data.Scope.ValueStringPointer(),
+		Ipaidpsub:              data.IdpUserId.ValueStringPointer(),
+	}
+	res, err := r.client.IdpAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa idp: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// The endpoints not set in the configuration are computed by FreeIPA.
+	data.AuthUri = types.StringPointerValue(res.Result.Ipaidpauthendpoint)
+	data.DevAuthUri = types.StringPointerValue(res.Result.Ipaidpdevauthendpoint)
+	data.TokenUri = types.StringPointerValue(res.Result.Ipaidptokenendpoint)
+	data.UserInfoUri = types.StringPointerValue(res.Result.Ipaidpuserinfoendpoint)
+	data.KeysUri = types.StringPointerValue(res.Result.Ipaidpkeysendpoint)
+	data.IssuerUrl = types.StringPointerValue(res.Result.Ipaidpissuerurl)
+	data.Scope = types.StringPointerValue(res.Result.Ipaidpscope)
+	data.IdpUserId = types.StringPointerValue(res.Result.Ipaidpsub)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.IdpShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.IdpShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.IdpShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] IdP not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa idp: %s", err))
+			return
+		}
+	}
+
+	data.Id = data.Name
+	data.ClientId = types.StringValue(res.Result.Ipaidpclientid)
+	data.AuthUri = types.StringPointerValue(res.Result.Ipaidpauthendpoint)
+	data.DevAuthUri = types.StringPointerValue(res.Result.Ipaidpdevauthendpoint)
+	data.TokenUri = types.StringPointerValue(res.Result.Ipaidptokenendpoint)
+	data.UserInfoUri = types.StringPointerValue(res.Result.Ipaidpuserinfoendpoint)
+	data.KeysUri = types.StringPointerValue(res.Result.Ipaidpkeysendpoint)
+	data.IssuerUrl = types.StringPointerValue(res.Result.Ipaidpissuerurl)
+	data.Scope = types.StringPointerValue(res.Result.Ipaidpscope)
+	data.IdpUserId = types.StringPointerValue(res.Result.Ipaidpsub)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdpResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdpModArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.IdpModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.AuthUri.Equal(state.AuthUri) {
+		optArgs.Ipaidpauthendpoint = data.AuthUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.DevAuthUri.Equal(state.DevAuthUri) {
+		optArgs.Ipaidpdevauthendpoint = data.DevAuthUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.TokenUri.Equal(state.TokenUri) {
+		optArgs.Ipaidptokenendpoint = data.TokenUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.UserInfoUri.Equal(state.UserInfoUri) {
+		optArgs.Ipaidpuserinfoendpoint = data.UserInfoUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.KeysUri.Equal(state.KeysUri) {
+		optArgs.Ipaidpkeysendpoint = data.KeysUri.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.IssuerUrl.Equal(state.IssuerUrl) {
+		optArgs.Ipaidpissuerurl = data.IssuerUrl.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.ClientId.Equal(state.ClientId) {
+		optArgs.Ipaidpclientid = data.ClientId.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Secret.Equal(state.Secret) {
+		if data.Secret.ValueStringPointer() != nil {
+			optArgs.Ipaidpclientsecret = data.Secret.ValueStringPointer()
+		} else {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipaidpclientsecret=")
+		}
+		hasChange = true
+	}
+	if !data.Scope.Equal(state.Scope) {
+		optArgs.Ipaidpscope = data.Scope.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.IdpUserId.Equal(state.IdpUserId) {
+		optArgs.Ipaidpsub = data.IdpUserId.ValueStringPointer()
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.IdpMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa idp: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdpResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.IdpDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.IdpDel(&args, &ipa.IdpDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa idp: %s", err))
+		return
+	}
+}
+
+func (r *IdpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
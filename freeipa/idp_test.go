@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdp_keycloak(t *testing.T) {
+	testIdp := map[string]string{
+		"index":         "0",
+		"name":          "\"testacc-idp-keycloak\"",
+		"provider_type": "\"keycloak\"",
+		"organization":  "\"testacc\"",
+		"base_url":      "\"keycloak.testacc.ipatest.lan:8443\"",
+		"client_id":     "\"testacc-client\"",
+		"secret":        "\"Secret123\"",
+	}
+	testIdpModified := map[string]string{
+		"index":         "0",
+		"name":          "\"testacc-idp-keycloak\"",
+		"provider_type": "\"keycloak\"",
+		"organization":  "\"testacc\"",
+		"base_url":      "\"keycloak.testacc.ipatest.lan:8443\"",
+		"client_id":     "\"testacc-client-2\"",
+		"scope":         "\"openid email\"",
+		"idp_user_id":   "\"email\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "name", "testacc-idp-keycloak"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "client_id", "testacc-client"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "auth_uri", "https://keycloak.testacc.ipatest.lan:8443/realms/testacc/protocol/openid-connect/auth"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "token_uri", "https://keycloak.testacc.ipatest.lan:8443/realms/testacc/protocol/openid-connect/token"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      "freeipa_idp.idp-0",
+				ImportState:       true,
+				ImportStateId:     "testacc-idp-keycloak",
+				ImportStateVerify: true,
+				// The template and the client secret are not returned by FreeIPA.
+				ImportStateVerifyIgnore: []string{"provider_type", "organization", "base_url", "secret"},
+				ImportStatePersist:      true,
+			},
+			{
+				// The template of the imported IdP is only recorded in the state, the IdP is not replaced.
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_idp.idp-0", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdpModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "client_id", "testacc-client-2"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "scope", "openid email"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "idp_user_id", "email"),
+					resource.TestCheckNoResourceAttr("freeipa_idp.idp-0", "secret"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdpModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAIdp_custom(t *testing.T) {
+	testIdp := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-idp-custom\"",
+		"auth_uri":     "\"https://idp.testacc.ipatest.lan/oauth2/authorize\"",
+		"dev_auth_uri": "\"https://idp.testacc.ipatest.lan/oauth2/device\"",
+		"token_uri":    "\"https://idp.testacc.ipatest.lan/oauth2/token\"",
+		"userinfo_uri": "\"https://idp.testacc.ipatest.lan/oauth2/userinfo\"",
+		"client_id":    "\"testacc-client\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "name", "testacc-idp-custom"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "dev_auth_uri", "https://idp.testacc.ipatest.lan/oauth2/device"),
+					resource.TestCheckResourceAttr("freeipa_idp.idp-0", "userinfo_uri", "https://idp.testacc.ipatest.lan/oauth2/userinfo"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdp_resource(testIdp),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdViewApplyResource{}
+var _ resource.ResourceWithImportState = &IdViewApplyResource{}
+
+func NewIdViewApplyResource() resource.Resource {
+	return &IdViewApplyResource{}
+}
+
+// IdViewApplyResource defines the resource implementation.
+type IdViewApplyResource struct {
+	client *ipa.Client
+}
+
+// IdViewApplyResourceModel describes the resource data model.
+type IdViewApplyResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	HostGroups types.List   `tfsdk:"hostgroups"`
+}
+
+func (r *IdViewApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idview_apply"
+}
+
+func (r *IdViewApplyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("hosts"),
+			path.MatchRoot("hostgroups"),
+		),
+	}
+}
+
+func (r *IdViewApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA ID view application resource.\nApplies an ID view to hosts and to the current members of host groups. A host can only have one ID view applied, applying a view replaces the previous one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view to apply",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts to apply the ID view to",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups whose members the ID view is applied to. Hosts added to the groups later are not affected.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *IdViewApplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdViewApplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdViewApplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, data.Name.ValueString(), listStringValues(data.Hosts), listStringValues(data.HostGroups), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdViewApplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdViewApplyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	showHosts := true
+	args := ipa.IdviewShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.IdviewShowOptionalArgs{
+		ShowHosts: &showHosts,
+	}
+
+	res, err := r.client.IdviewShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] ID view not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa ID view: %s", err))
+			return
+		}
+	}
+
+	// Host groups are expanded when the view is applied, only the hosts can be checked.
+	if !data.Hosts.IsNull() {
+		var diag diag.Diagnostics
+		data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hosts, res.Result.Appliedtohosts))
+		resp.Diagnostics.Append(diag...)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdViewApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdViewApplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addedHosts, deletedHosts := diffStringLists(state.Hosts, data.Hosts)
+	addedHostGroups, deletedHostGroups := diffStringLists(state.HostGroups, data.HostGroups)
+
+	// The hosts still planned, directly or through a host group, keep the view.
+	if len(deletedHosts) > 0 || len(deletedHostGroups) > 0 {
+		keep, err := r.hostgroupsHosts(listStringValues(data.HostGroups))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa hostgroup: %s", err))
+			return
+		}
+		keep = append(keep, listStringValues(data.Hosts)...)
+		r.unapply(ctx, data.Name.ValueString(), deletedHosts, deletedHostGroups, keep, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.apply(ctx, data.Name.ValueString(), addedHosts, addedHostGroups, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdViewApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdViewApplyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.unapply(ctx, data.Name.ValueString(), listStringValues(data.Hosts), listStringValues(data.HostGroups), nil, &resp.Diagnostics)
+}
+
+func (r *IdViewApplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	showHosts := true
+	res, err := r.client.IdviewShow(&ipa.IdviewShowArgs{Cn: req.ID}, &ipa.IdviewShowOptionalArgs{ShowHosts: &showHosts})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa ID view: %s", err))
+		return
+	}
+
+	// The imported resource manages all the hosts the view is currently applied to.
+	var hosts []string
+	if res.Result.Appliedtohosts != nil {
+		hosts = *res.Result.Appliedtohosts
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), hosts)...)
+}
+
+// apply applies the ID view to the hosts and to the members of the host groups.
+func (r *IdViewApplyResource) apply(ctx context.Context, name string, hosts []string, hostgroups []string, diags *diag.Diagnostics) {
+	if len(hosts) == 0 && len(hostgroups) == 0 {
+		return
+	}
+	optArgs := ipa.IdviewApplyOptionalArgs{}
+	if len(hosts) > 0 {
+		optArgs.Host = &hosts
+	}
+	if len(hostgroups) > 0 {
+		optArgs.Hostgroup = &hostgroups
+	}
+	res, err := r.client.IdviewApply(&ipa.IdviewApplyArgs{Cn: name}, &optArgs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error applying freeipa ID view %s: %s", name, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Applied freeipa ID view %s to %d hosts", name, res.Completed))
+	if res.Completed == 0 {
+		diags.AddWarning("Client Warning", fmt.Sprintf("Warning applying freeipa ID view %s: %v", name, res.Failed))
+	}
+}
+
+// unapply removes the ID view from the hosts and from the members of the host groups, except from the hosts to keep.
+// Only the hosts the view is applied to are unapplied, the hosts with another view keep it.
+func (r *IdViewApplyResource) unapply(ctx context.Context, name string, hosts []string, hostgroups []string, keep []string, diags *diag.Diagnostics) {
+	if len(hosts) == 0 && len(hostgroups) == 0 {
+		return
+	}
+	showHosts := true
+	view, err := r.client.IdviewShow(&ipa.IdviewShowArgs{Cn: name}, &ipa.IdviewShowOptionalArgs{ShowHosts: &showHosts})
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] ID view %s not found, nothing to unapply", name))
+			return
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa ID view %s: %s", name, err))
+		return
+	}
+	if view.Result.Appliedtohosts == nil {
+		return
+	}
+
+	groupHosts, err := r.hostgroupsHosts(hostgroups)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa hostgroup: %s", err))
+		return
+	}
+	var unapplied []string
+	for _, host := range append(hosts, groupHosts...) {
+		if !isStringListContainsCaseInsensistive(view.Result.Appliedtohosts, &host) || isStringListContainsCaseInsensistive(&keep, &host) || isStringListContainsCaseInsensistive(&unapplied, &host) {
+			continue
+		}
+		unapplied = append(unapplied, host)
+	}
+	if len(unapplied) == 0 {
+		return
+	}
+
+	res, err := r.client.IdviewUnapply(&ipa.IdviewUnapplyArgs{}, &ipa.IdviewUnapplyOptionalArgs{Host: &unapplied})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error unapplying freeipa ID view %s: %s", name, err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Unapplied freeipa ID view %s from %d hosts", name, res.Completed))
+}
+
+// hostgroupsHosts returns the member hosts of the host groups, the view being applied to them and not to the groups.
+func (r *IdViewApplyResource) hostgroupsHosts(hostgroups []string) ([]string, error) {
+	var hosts []string
+	for _, hostgroup := range hostgroups {
+		res, err := r.client.HostgroupShow(&ipa.HostgroupShowArgs{Cn: hostgroup}, &ipa.HostgroupShowOptionalArgs{})
+		if err != nil {
+			if strings.Contains(err.Error(), "NotFound") {
+				continue
+			}
+			return nil, err
+		}
+		if res.Result.MemberHost != nil {
+			hosts = append(hosts, *res.Result.MemberHost...)
+		}
+	}
+	return hosts, nil
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdViewApply_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testIdView := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testIdViewApply := map[string]string{
+		"index": "0",
+		"name":  "freeipa_idview.idview-0.name",
+		"hosts": "[freeipa_host.host-0.name]",
+	}
+	testHostGroupMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_hostgroup.hostgroup-0.name",
+		"host":  "freeipa_host.host-0.name",
+	}
+	testIdViewApplyHostGroup := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_idview.idview-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_host_hostgroup_membership.membership-0.name]",
+	}
+	testIdViewApplyModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_idview.idview-0.name",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdViewApply_resource(testIdViewApply),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "name", "testacc-idview"),
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdViewApply_resource(testIdViewApply),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAHostGroupMembership_resource(testHostGroupMembership) + testAccFreeIPAIdViewApply_resource(testIdViewApplyHostGroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hostgroups.#", "1"),
+				),
+			},
+			{
+				// The host is still listed in hosts, it must keep the view when its host group is removed.
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAHostGroupMembership_resource(testHostGroupMembership) + testAccFreeIPAIdViewApply_resource(testIdViewApply),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckNoResourceAttr("freeipa_idview_apply.idview-apply-0", "hostgroups"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAHostGroupMembership_resource(testHostGroupMembership) + testAccFreeIPAIdViewApply_resource(testIdViewApply),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAIdView_resource(testIdView) + testAccFreeIPAIdViewApply_resource(testIdViewApplyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hostgroups.#", "1"),
+					resource.TestCheckNoResourceAttr("freeipa_idview_apply.idview-apply-0", "hosts"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAIdViewApply_otherView(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost0 := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHost1 := map[string]string{
+		"index":      "1",
+		"name":       "\"testacc-host-2.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.66\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testHostGroupMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_hostgroup.hostgroup-0.name",
+		"hosts": "[freeipa_host.host-0.name, freeipa_host.host-1.name]",
+	}
+	testIdView0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testIdView1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-idview-2\"",
+	}
+	testIdViewApply0 := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_idview.idview-0.name",
+		"hostgroups": "[freeipa_host_hostgroup_membership.membership-0.name]",
+	}
+	testIdViewApply1 := map[string]string{
+		"index": "1",
+		"name":  "freeipa_idview.idview-1.name",
+		"hosts": "[freeipa_host.host-1.name]",
+	}
+	baseConfig := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost0) + testAccFreeIPAHost_resource(testHost1) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAHostGroupMembership_resource(testHostGroupMembership) + testAccFreeIPAIdView_resource(testIdView0) + testAccFreeIPAIdView_resource(testIdView1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + testAccFreeIPAIdViewApply_resource(testIdViewApply0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-0", "hostgroups.#", "1"),
+				),
+			},
+			{
+				// The second view replaces the first one on the second host.
+				Config: baseConfig + testAccFreeIPAIdViewApply_resource(testIdViewApply0) + testAccFreeIPAIdViewApply_resource(testIdViewApply1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview_apply.idview-apply-1", "hosts.#", "1"),
+				),
+			},
+			{
+				// Removing the first view from the host group members must not remove the second view.
+				Config: baseConfig + testAccFreeIPAIdViewApply_resource(testIdViewApply1),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAIdViewApply_resource(testIdViewApply1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
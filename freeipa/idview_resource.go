@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IdViewResource{}
+var _ resource.ResourceWithImportState = &IdViewResource{}
+
+func NewIdViewResource() resource.Resource {
+	return &IdViewResource{}
+}
+
+// IdViewResource defines the resource implementation.
+type IdViewResource struct {
+	client *ipa.Client
+}
+
+// IdViewResourceModel describes the resource data model.
+type IdViewResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	DomainResolutionOrder types.String `tfsdk:"domain_resolution_order"`
+}
+
+func (r *IdViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_idview"
+}
+
+func (r *IdViewResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *IdViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA ID view resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ID view",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "ID view description",
+				Optional:            true,
+			},
+			"domain_resolution_order": schema.StringAttribute{
+				MarkdownDescription: "Colon-separated list of domains used for short name qualification by the hosts the view is applied to",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *IdViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IdViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IdViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdviewAddOptionalArgs{}
+
+	args := ipa.IdviewAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.DomainResolutionOrder.IsNull() {
+		optArgs.Ipadomainresolutionorder = data.DomainResolutionOrder.ValueStringPointer()
+	}
+	_, err := r.client.IdviewAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa ID view: %s", err))
+	}
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IdViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.IdviewShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.IdviewShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.IdviewShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] ID view not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa ID view: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa ID view %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa ID view %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipadomainresolutionorder != nil && !data.DomainResolutionOrder.IsNull() {
+		data.DomainResolutionOrder = types.StringValue(*res.Result.Ipadomainresolutionorder)
+	}
+	data.Id = data.Name
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa ID view %s", res.Result.Cn))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IdViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IdViewResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.IdviewModOptionalArgs{}
+
+	args := ipa.IdviewModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa ID view %s from plan = %v", data.Name.ValueString(), data))
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+	}
+	if !data.DomainResolutionOrder.Equal(state.DomainResolutionOrder) {
+		if data.DomainResolutionOrder.ValueStringPointer() != nil {
+			optArgs.Ipadomainresolutionorder = data.DomainResolutionOrder.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipadomainresolutionorder = &v
+		}
+	}
+	_, err := r.client.IdviewMod(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa ID view: %s", err))
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IdViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IdViewResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa ID view Id %s", data.Id.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa ID view Name %s", data.Name.ValueString()))
+	args := ipa.IdviewDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	optArgs := ipa.IdviewDelOptionalArgs{}
+	_, err := r.client.IdviewDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] ID view %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+
+}
+
+func (r *IdViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAIdView_full(t *testing.T) {
+	testIdView := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-idview\"",
+	}
+	testIdViewModified := map[string]string{
+		"index":                   "0",
+		"name":                    "\"testacc-idview\"",
+		"description":             "\"An ID view for acceptance tests\"",
+		"domain_resolution_order": "\"ipatest.lan\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdView_resource(testIdView),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview.idview-0", "name", "testacc-idview"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdView_resource(testIdViewModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_idview.idview-0", "description", "An ID view for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_idview.idview-0", "domain_resolution_order", "ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAIdView_resource(testIdViewModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,503 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// globalKerberosTicketPolicy is the ID of the kerberos ticket policy applying to the users without their own policy.
+const globalKerberosTicketPolicy = "global"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KerberosTicketPolicyResource{}
+var _ resource.ResourceWithImportState = &KerberosTicketPolicyResource{}
+
+func NewKerberosTicketPolicyResource() resource.Resource {
+	return &KerberosTicketPolicyResource{}
+}
+
+// KerberosTicketPolicyResource defines the resource implementation.
+type KerberosTicketPolicyResource struct {
+	client *ipa.Client
+}
+
+// KerberosTicketPolicyResourceModel describes the resource data model.
+type KerberosTicketPolicyResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	User             types.String `tfsdk:"user"`
+	MaxLife          types.Int64  `tfsdk:"max_life"`
+	MaxRenew         types.Int64  `tfsdk:"max_renew"`
+	OtpMaxLife       types.Int64  `tfsdk:"otp_max_life"`
+	OtpMaxRenew      types.Int64  `tfsdk:"otp_max_renew"`
+	RadiusMaxLife    types.Int64  `tfsdk:"radius_max_life"`
+	RadiusMaxRenew   types.Int64  `tfsdk:"radius_max_renew"`
+	PkinitMaxLife    types.Int64  `tfsdk:"pkinit_max_life"`
+	PkinitMaxRenew   types.Int64  `tfsdk:"pkinit_max_renew"`
+	HardenedMaxLife  types.Int64  `tfsdk:"hardened_max_life"`
+	HardenedMaxRenew types.Int64  `tfsdk:"hardened_max_renew"`
+	IdpMaxLife       types.Int64  `tfsdk:"idp_max_life"`
+	IdpMaxRenew      types.Int64  `tfsdk:"idp_max_renew"`
+	PasskeyMaxLife   types.Int64  `tfsdk:"passkey_max_life"`
+	PasskeyMaxRenew  types.Int64  `tfsdk:"passkey_max_renew"`
+}
+
+func (r *KerberosTicketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kerberos_ticket_policy"
+}
+
+func (r *KerberosTicketPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Kerberos ticket policy resource.\nManages the global Kerberos ticket policy, or the policy of a user when `user` is set. The policy is reset to its defaults on destruction, and the lifetimes removed from the configuration are reset to their defaults.\nThe per authentication indicator lifetimes apply to the tickets obtained with the matching `auth_type` of the user.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, `global` for the global Kerberos ticket policy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user the Kerberos ticket policy applies to. The global Kerberos ticket policy is managed when not set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"otp_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the OTP authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"otp_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the OTP authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"radius_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the RADIUS authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"radius_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the RADIUS authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"pkinit_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the PKINIT authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"pkinit_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the PKINIT authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"hardened_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the hardened authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"hardened_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the hardened authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"idp_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the external IdP authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"idp_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the external IdP authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"passkey_max_life": schema.Int64Attribute{
+				MarkdownDescription: "Maximum ticket life for the passkey authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"passkey_max_renew": schema.Int64Attribute{
+				MarkdownDescription: "Maximum renewable age for the passkey authentication indicator (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+		},
+	}
+}
+
+func (r *KerberosTicketPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KerberosTicketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Kerberos ticket policies always exist, they are only modified.
+	optArgs := ipa.KrbtpolicyModOptionalArgs{
+		Uid:                               data.User.ValueStringPointer(),
+		Krbmaxticketlife:                  intPointer(data.MaxLife),
+		Krbmaxrenewableage:                intPointer(data.MaxRenew),
+		KrbauthindmaxticketlifeOtp:        intPointer(data.OtpMaxLife),
+		KrbauthindmaxrenewableageOtp:      intPointer(data.OtpMaxRenew),
+		KrbauthindmaxticketlifeRadius:     intPointer(data.RadiusMaxLife),
+		KrbauthindmaxrenewableageRadius:   intPointer(data.RadiusMaxRenew),
+		KrbauthindmaxticketlifePkinit:     intPointer(data.PkinitMaxLife),
+		KrbauthindmaxrenewableagePkinit:   intPointer(data.PkinitMaxRenew),
+		KrbauthindmaxticketlifeHardened:   intPointer(data.HardenedMaxLife),
+		KrbauthindmaxrenewableageHardened: intPointer(data.HardenedMaxRenew),
+		KrbauthindmaxticketlifeIdp:        intPointer(data.IdpMaxLife),
+		KrbauthindmaxrenewableageIdp:      intPointer(data.IdpMaxRenew),
+		KrbauthindmaxticketlifePasskey:    intPointer(data.PasskeyMaxLife),
+		KrbauthindmaxrenewableagePasskey:  intPointer(data.PasskeyMaxRenew),
+	}
+	_, err := r.client.KrbtpolicyMod(&ipa.KrbtpolicyModArgs{}, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa kerberos ticket policy: %s", err))
+		return
+	}
+	if data.User.IsNull() {
+		data.Id = types.StringValue(globalKerberosTicketPolicy)
+	} else {
+		data.Id = data.User
+	}
+
+	// The attributes not set in the configuration are computed by FreeIPA.
+	r.readKerberosTicketPolicy(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredAttributes(ctx, req.Config, resp.Private)...)
+}
+
+func (r *KerberosTicketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.readKerberosTicketPolicy(ctx, &data, &resp.Diagnostics) {
+		tflog.Debug(ctx, "[DEBUG] Kerberos ticket policy user not found")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KerberosTicketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KerberosTicketPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lifetimes removed from the configuration are unknown in the plan. They are reset by resetting
+	// the whole policy, the lifetimes still configured are then set again.
+	reset := false
+	for _, value := range []types.Int64{data.MaxLife, data.MaxRenew, data.OtpMaxLife, data.OtpMaxRenew, data.RadiusMaxLife, data.RadiusMaxRenew, data.PkinitMaxLife, data.PkinitMaxRenew, data.HardenedMaxLife, data.HardenedMaxRenew, data.IdpMaxLife, data.IdpMaxRenew, data.PasskeyMaxLife, data.PasskeyMaxRenew} {
+		if value.IsUnknown() {
+			reset = true
+		}
+	}
+	if reset {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Reset freeipa kerberos ticket policy %s", data.Id.ValueString()))
+		_, err := r.client.KrbtpolicyReset(&ipa.KrbtpolicyResetArgs{}, &ipa.KrbtpolicyResetOptionalArgs{Uid: data.User.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error resetting freeipa kerberos ticket policy: %s", err))
+			return
+		}
+		state = KerberosTicketPolicyResourceModel{}
+	}
+
+	optArgs := ipa.KrbtpolicyModOptionalArgs{
+		Uid: data.User.ValueStringPointer(),
+	}
+
+	if !data.MaxLife.Equal(state.MaxLife) {
+		optArgs.Krbmaxticketlife = intPointer(data.MaxLife)
+	}
+	if !data.MaxRenew.Equal(state.MaxRenew) {
+		optArgs.Krbmaxrenewableage = intPointer(data.MaxRenew)
+	}
+	if !data.OtpMaxLife.Equal(state.OtpMaxLife) {
+		optArgs.KrbauthindmaxticketlifeOtp = intPointer(data.OtpMaxLife)
+	}
+	if !data.OtpMaxRenew.Equal(state.OtpMaxRenew) {
+		optArgs.KrbauthindmaxrenewableageOtp = intPointer(data.OtpMaxRenew)
+	}
+	if !data.RadiusMaxLife.Equal(state.RadiusMaxLife) {
+		optArgs.KrbauthindmaxticketlifeRadius = intPointer(data.RadiusMaxLife)
+	}
+	if !data.RadiusMaxRenew.Equal(state.RadiusMaxRenew) {
+		optArgs.KrbauthindmaxrenewableageRadius = intPointer(data.RadiusMaxRenew)
+	}
+	if !data.PkinitMaxLife.Equal(state.PkinitMaxLife) {
+		optArgs.KrbauthindmaxticketlifePkinit = intPointer(data.PkinitMaxLife)
+	}
+	if !data.PkinitMaxRenew.Equal(state.PkinitMaxRenew) {
+		optArgs.KrbauthindmaxrenewableagePkinit = intPointer(data.PkinitMaxRenew)
+	}
+	if !data.HardenedMaxLife.Equal(state.HardenedMaxLife) {
+		optArgs.KrbauthindmaxticketlifeHardened = intPointer(data.HardenedMaxLife)
+	}
+	if !data.HardenedMaxRenew.Equal(state.HardenedMaxRenew) {
+		optArgs.KrbauthindmaxrenewableageHardened = intPointer(data.HardenedMaxRenew)
+	}
+	if !data.IdpMaxLife.Equal(state.IdpMaxLife) {
+		optArgs.KrbauthindmaxticketlifeIdp = intPointer(data.IdpMaxLife)
+	}
+	if !data.IdpMaxRenew.Equal(state.IdpMaxRenew) {
+		optArgs.KrbauthindmaxrenewableageIdp = intPointer(data.IdpMaxRenew)
+	}
+	if !data.PasskeyMaxLife.Equal(state.PasskeyMaxLife) {
+		optArgs.KrbauthindmaxticketlifePasskey = intPointer(data.PasskeyMaxLife)
+	}
+	if !data.PasskeyMaxRenew.Equal(state.PasskeyMaxRenew) {
+		optArgs.KrbauthindmaxrenewableagePasskey = intPointer(data.PasskeyMaxRenew)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa kerberos ticket policy %s from plan = %v", data.Id.ValueString(), data))
+	_, err := r.client.KrbtpolicyMod(&ipa.KrbtpolicyModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			if !reset {
+				resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("EmptyModlist (4202): no modifications to be performed on kerberos ticket policy %s", data.Id.ValueString()))
+			}
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa kerberos ticket policy: %s", err))
+			return
+		}
+	}
+
+	// The reset attributes are computed by FreeIPA.
+	r.readKerberosTicketPolicy(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredAttributes(ctx, req.Config, resp.Private)...)
+}
+
+func (r *KerberosTicketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KerberosTicketPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Reset freeipa kerberos ticket policy %s", data.Id.ValueString()))
+	optArgs := ipa.KrbtpolicyResetOptionalArgs{
+		Uid: data.User.ValueStringPointer(),
+	}
+	_, err := r.client.KrbtpolicyReset(&ipa.KrbtpolicyResetArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Kerberos ticket policy user not found")
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Kerberos ticket policy %s reset failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *KerberosTicketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if req.ID != globalKerberosTicketPolicy {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), req.ID)...)
+	}
+}
+
+// readKerberosTicketPolicy updates the model with the kerberos ticket policy read from FreeIPA.
+// It returns false when the user of the kerberos ticket policy does not exist.
+func (r *KerberosTicketPolicyResource) readKerberosTicketPolicy(ctx context.Context, data *KerberosTicketPolicyResourceModel, diags *diag.Diagnostics) bool {
+	all := true
+	optArgs := ipa.KrbtpolicyShowOptionalArgs{
+		Uid: data.User.ValueStringPointer(),
+		All: &all,
+	}
+
+	res, err := r.client.KrbtpolicyShow(&ipa.KrbtpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return false
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa kerberos ticket policy: %s", err))
+		return true
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa kerberos ticket policy %s", res.Result.String()))
+
+	data.MaxLife = int64Value(res.Result.Krbmaxticketlife)
+	data.MaxRenew = int64Value(res.Result.Krbmaxrenewableage)
+	data.OtpMaxLife = int64Value(res.Result.KrbauthindmaxticketlifeOtp)
+	data.OtpMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableageOtp)
+	data.RadiusMaxLife = int64Value(res.Result.KrbauthindmaxticketlifeRadius)
+	data.RadiusMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableageRadius)
+	data.PkinitMaxLife = int64Value(res.Result.KrbauthindmaxticketlifePkinit)
+	data.PkinitMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableagePkinit)
+	data.HardenedMaxLife = int64Value(res.Result.KrbauthindmaxticketlifeHardened)
+	data.HardenedMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableageHardened)
+	data.IdpMaxLife = int64Value(res.Result.KrbauthindmaxticketlifeIdp)
+	data.IdpMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableageIdp)
+	data.PasskeyMaxLife = int64Value(res.Result.KrbauthindmaxticketlifePasskey)
+	data.PasskeyMaxRenew = int64Value(res.Result.KrbauthindmaxrenewableagePasskey)
+	return true
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAKerberosTicketPolicy_user(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testKrbtPolicy := map[string]string{
+		"index":     "0",
+		"user":      "freeipa_user.user-0.name",
+		"max_life":  "36000",
+		"max_renew": "72000",
+	}
+	testKrbtPolicyModified := map[string]string{
+		"index":           "0",
+		"user":            "freeipa_user.user-0.name",
+		"max_life":        "28800",
+		"max_renew":       "57600",
+		"otp_max_life":    "3600",
+		"otp_max_renew":   "7200",
+		"pkinit_max_life": "14400",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "id", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_life", "36000"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_renew", "72000"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_life", "28800"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_renew", "57600"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "otp_max_life", "3600"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "otp_max_renew", "7200"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "pkinit_max_life", "14400"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// The lifetimes removed from the configuration are reset.
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_life", "36000"),
+					resource.TestCheckResourceAttr("freeipa_kerberos_ticket_policy.krbtpolicy-0", "max_renew", "72000"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAKerberosTicketPolicy_resource(testKrbtPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,564 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KeytabPermissionResource{}
+var _ resource.ResourceWithConfigValidators = &KeytabPermissionResource{}
+var _ resource.ResourceWithImportState = &KeytabPermissionResource{}
+
+// keytabMembers holds the principals allowed to create or retrieve a keytab.
+type keytabMembers struct {
+	User      *[]string
+	Group     *[]string
+	Host      *[]string
+	Hostgroup *[]string
+}
+
+// KeytabPermissionResource defines the implementation of the resources allowing principals
+// to create or retrieve the keytab of a host or a service.
+type KeytabPermissionResource struct {
+	client *ipa.Client
+	// object is the type of the object owning the keytab, host or service.
+	object string
+	// operation is the operation allowed on the keytab, create or retrieve.
+	operation       string
+	nameDescription string
+	description     string
+	allow           func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error)
+	disallow        func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error)
+	show            func(client *ipa.Client, name string, operation string) (*keytabMembers, error)
+}
+
+// KeytabPermissionResourceModel describes the resource data model.
+type KeytabPermissionResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Group      types.String `tfsdk:"group"`
+	Host       types.String `tfsdk:"host"`
+	HostGroup  types.String `tfsdk:"hostgroup"`
+	Users      types.List   `tfsdk:"users"`
+	Groups     types.List   `tfsdk:"groups"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	HostGroups types.List   `tfsdk:"hostgroups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *KeytabPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s_allow_%s_keytab", req.ProviderTypeName, r.object, r.operation)
+}
+
+func (r *KeytabPermissionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	validators := []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("users"),
+			path.MatchRoot("groups"),
+			path.MatchRoot("hosts"),
+			path.MatchRoot("hostgroups"),
+		),
+	}
+	// A single member cannot be combined with a list of members.
+	for _, single := range []string{"user", "group", "host", "hostgroup"} {
+		for _, multiple := range []string{"users", "groups", "hosts", "hostgroups"} {
+			validators = append(validators, resourcevalidator.Conflicting(
+				path.MatchRoot(single),
+				path.MatchRoot(multiple),
+			))
+		}
+	}
+	return validators
+}
+
+func (r *KeytabPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := fmt.Sprintf("FreeIPA %s keytab %s permission resource.\nAllowing a principal that is already allowed in FreeIPA will result in a warning but the principal will be added to the state.", r.object, r.operation)
+	if r.description != "" {
+		description += "\n" + r.description
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: description,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: r.nameDescription,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("User allowed to %s the keytab", r.operation),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("User group allowed to %s the keytab", r.operation),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Host allowed to %s the keytab", r.operation),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Hostgroup allowed to %s the keytab", r.operation),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("Users allowed to %s the keytab", r.operation),
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("User groups allowed to %s the keytab", r.operation),
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("Hosts allowed to %s the keytab", r.operation),
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("Hostgroups allowed to %s the keytab", r.operation),
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Unique identifier to differentiate multiple resources on the same %s. Mandatory for using users/groups/hosts/hostgroups configurations.", r.object),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *KeytabPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KeytabPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KeytabPermissionResourceModel
+	var id, typeId string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := keytabMembers{}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		members.User = &v
+		typeId = "u"
+		id = data.User.ValueString()
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		members.Group = &v
+		typeId = "g"
+		id = data.Group.ValueString()
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		members.Host = &v
+		typeId = "h"
+		id = data.Host.ValueString()
+	}
+	if !data.HostGroup.IsNull() {
+		v := []string{data.HostGroup.ValueString()}
+		members.Hostgroup = &v
+		typeId = "hg"
+		id = data.HostGroup.ValueString()
+	}
+	if !data.Users.IsNull() || !data.Groups.IsNull() || !data.Hosts.IsNull() || !data.HostGroups.IsNull() {
+		members = keytabListMembers(&data)
+		typeId = "m"
+		id = data.Identifier.ValueString()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Allow %s keytab of freeipa %s %s", r.operation, r.object, data.Name.ValueString()))
+	_v, err := r.allow(r.client, data.Name.ValueString(), members)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error allowing to %s keytab of freeipa %s %s: %s", r.operation, r.object, data.Name.ValueString(), err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning allowing to %s keytab of freeipa %s %s: %v", r.operation, r.object, data.Name.ValueString(), _v.Failed))
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), typeId, id))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeytabPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KeytabPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, typeId, memberId, err := parseKeytabPermissionID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_%s_allow_%s_keytab: %s", r.object, r.operation, err))
+		return
+	}
+
+	allowed, err := r.show(r.client, name, r.operation)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa %s %s not found", r.object, name))
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa %s: %s", r.object, err))
+			return
+		}
+	}
+
+	var members *[]string
+	switch typeId {
+	case "u":
+		members = allowed.User
+	case "g":
+		members = allowed.Group
+	case "h":
+		members = allowed.Host
+	case "hg":
+		members = allowed.Hostgroup
+	case "m":
+		if !data.Users.IsNull() {
+			var diag diag.Diagnostics
+			data.Users, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Users, allowed.User))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Groups.IsNull() {
+			var diag diag.Diagnostics
+			data.Groups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Groups, allowed.Group))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hosts.IsNull() {
+			var diag diag.Diagnostics
+			data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hosts, allowed.Host))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.HostGroups.IsNull() {
+			var diag diag.Diagnostics
+			data.HostGroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.HostGroups, allowed.Hostgroup))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+	if typeId != "m" && (members == nil || !isStringListContainsCaseInsensistive(members, &memberId)) {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] %s not allowed to %s keytab", memberId, r.operation))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *KeytabPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KeytabPermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowed := keytabMembers{}
+	disallowed := keytabMembers{}
+	hasAllow := false
+	hasDisallow := false
+	// Principals can be allowed or disallowed, comparing the current state and the plan allows us to define 2 lists of principals to allow or disallow.
+	for _, lists := range []struct {
+		state, plan         types.List
+		allowed, disallowed **[]string
+	}{
+		{state.Users, data.Users, &allowed.User, &disallowed.User},
+		{state.Groups, data.Groups, &allowed.Group, &disallowed.Group},
+		{state.Hosts, data.Hosts, &allowed.Host, &disallowed.Host},
+		{state.HostGroups, data.HostGroups, &allowed.Hostgroup, &disallowed.Hostgroup},
+	} {
+		if lists.plan.Equal(lists.state) {
+			continue
+		}
+		added, removed := diffStringLists(lists.state, lists.plan)
+		if len(added) > 0 {
+			*lists.allowed = &added
+			hasAllow = true
+		}
+		if len(removed) > 0 {
+			*lists.disallowed = &removed
+			hasDisallow = true
+		}
+	}
+	// The api provides an allow and a disallow function. Therefore we need to call the right one when appropriate.
+	if hasAllow {
+		_v, err := r.allow(r.client, data.Name.ValueString(), allowed)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error allowing to %s keytab of freeipa %s %s: %s", r.operation, r.object, data.Name.ValueString(), err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning allowing to %s keytab of freeipa %s %s: %v", r.operation, r.object, data.Name.ValueString(), _v.Failed))
+		}
+	}
+	if hasDisallow {
+		_v, err := r.disallow(r.client, data.Name.ValueString(), disallowed)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disallowing to %s keytab of freeipa %s %s: %s", r.operation, r.object, data.Name.ValueString(), err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disallowing to %s keytab of freeipa %s %s: %v", r.operation, r.object, data.Name.ValueString(), _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeytabPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KeytabPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, typeId, memberId, err := parseKeytabPermissionID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_%s_allow_%s_keytab: %s", r.object, r.operation, err))
+		return
+	}
+
+	members := keytabMembers{}
+	v := []string{memberId}
+	switch typeId {
+	case "u":
+		members.User = &v
+	case "g":
+		members.Group = &v
+	case "h":
+		members.Host = &v
+	case "hg":
+		members.Hostgroup = &v
+	case "m":
+		members = keytabListMembers(&data)
+	}
+
+	_, err = r.disallow(r.client, name, members)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa %s %s not found", r.object, name))
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disallowing to %s keytab of freeipa %s %s: %s", r.operation, r.object, name, err))
+		return
+	}
+}
+
+func (r *KeytabPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, typeId, memberId, err := parseKeytabPermissionID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "m":
+		resp.Diagnostics.AddError(
+			"Import with multi-value ID format not supported",
+			fmt.Sprintf("The ID %q uses the multi-value format. "+
+				"Only the single-value formats with type 'u', 'g', 'h' or 'hg' can be imported.", req.ID),
+		)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+// keytabListMembers returns the principals of the users/groups/hosts/hostgroups lists.
+func keytabListMembers(data *KeytabPermissionResourceModel) keytabMembers {
+	members := keytabMembers{}
+	if !data.Users.IsNull() {
+		v := listStringValues(data.Users)
+		members.User = &v
+	}
+	if !data.Groups.IsNull() {
+		v := listStringValues(data.Groups)
+		members.Group = &v
+	}
+	if !data.Hosts.IsNull() {
+		v := listStringValues(data.Hosts)
+		members.Host = &v
+	}
+	if !data.HostGroups.IsNull() {
+		v := listStringValues(data.HostGroups)
+		members.Hostgroup = &v
+	}
+	return members
+}
+
+// showHostKeytabMembers returns the principals allowed to create or retrieve the keytab of a host.
+func showHostKeytabMembers(client *ipa.Client, name string, operation string) (*keytabMembers, error) {
+	all := true
+	res, err := client.HostShow(&ipa.HostShowArgs{Fqdn: name}, &ipa.HostShowOptionalArgs{All: &all})
+	if err != nil {
+		return nil, err
+	}
+	if operation == "create" {
+		return &keytabMembers{
+			User:      res.Result.IpaallowedtoperformWriteKeysUser,
+			Group:     res.Result.IpaallowedtoperformWriteKeysGroup,
+			Host:      res.Result.IpaallowedtoperformWriteKeysHost,
+			Hostgroup: res.Result.IpaallowedtoperformWriteKeysHostgroup,
+		}, nil
+	}
+	return &keytabMembers{
+		User:      res.Result.IpaallowedtoperformReadKeysUser,
+		Group:     res.Result.IpaallowedtoperformReadKeysGroup,
+		Host:      res.Result.IpaallowedtoperformReadKeysHost,
+		Hostgroup: res.Result.IpaallowedtoperformReadKeysHostgroup,
+	}, nil
+}
+
+// showServiceKeytabMembers returns the principals allowed to create or retrieve the keytab of a service.
+func showServiceKeytabMembers(client *ipa.Client, name string, operation string) (*keytabMembers, error) {
+	all := true
+	res, err := client.ServiceShow(&ipa.ServiceShowArgs{Krbcanonicalname: name}, &ipa.ServiceShowOptionalArgs{All: &all})
+	if err != nil {
+		return nil, err
+	}
+	if operation == "create" {
+		return &keytabMembers{
+			User:      res.Result.IpaallowedtoperformWriteKeysUser,
+			Group:     res.Result.IpaallowedtoperformWriteKeysGroup,
+			Host:      res.Result.IpaallowedtoperformWriteKeysHost,
+			Hostgroup: res.Result.IpaallowedtoperformWriteKeysHostgroup,
+		}, nil
+	}
+	return &keytabMembers{
+		User:      res.Result.IpaallowedtoperformReadKeysUser,
+		Group:     res.Result.IpaallowedtoperformReadKeysGroup,
+		Host:      res.Result.IpaallowedtoperformReadKeysHost,
+		Hostgroup: res.Result.IpaallowedtoperformReadKeysHostgroup,
+	}, nil
+}
+
+func parseKeytabPermissionID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine keytab permission ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	member := idParts[2]
+
+	return name, _type, member, nil
+}
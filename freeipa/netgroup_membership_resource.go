@@ -0,0 +1,787 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetgroupMembershipResource{}
+var _ resource.ResourceWithImportState = &NetgroupMembershipResource{}
+
+func NewNetgroupMembershipResource() resource.Resource {
+	return &NetgroupMembershipResource{}
+}
+
+// NetgroupMembershipResource defines the resource implementation.
+type NetgroupMembershipResource struct {
+	client *ipa.Client
+}
+
+// NetgroupMembershipResourceModel describes the resource data model.
+type NetgroupMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Users      types.List   `tfsdk:"users"`
+	Group      types.String `tfsdk:"group"`
+	Groups     types.List   `tfsdk:"groups"`
+	Host       types.String `tfsdk:"host"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	Hostgroup  types.String `tfsdk:"hostgroup"`
+	Hostgroups types.List   `tfsdk:"hostgroups"`
+	Netgroup   types.String `tfsdk:"netgroup"`
+	Netgroups  types.List   `tfsdk:"netgroups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *NetgroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_netgroup_membership"
+}
+
+func (r *NetgroupMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("host"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("netgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("netgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("netgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("netgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("netgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("netgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("netgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("netgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("netgroups"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("netgroup"),
+			path.MatchRoot("users"),
+			path.MatchRoot("groups"),
+			path.MatchRoot("hosts"),
+			path.MatchRoot("hostgroups"),
+			path.MatchRoot("netgroups"),
+		),
+	}
+}
+
+func (r *NetgroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA netgroup membership resource.\nHosts unknown to FreeIPA are added to the netgroup as external hosts.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the netgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User to add to the netgroup",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "List of users to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group to add to the netgroup",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host to add to the netgroup",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Host group to add to the netgroup",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"netgroup": schema.StringAttribute{
+				MarkdownDescription: "Netgroup to add to the netgroup",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"netgroups": schema.ListAttribute{
+				MarkdownDescription: "List of netgroups to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple netgroup membership resources on the same netgroup. Mandatory for using users/groups/hosts/hostgroups/netgroups configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetgroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetgroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetgroupMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.NetgroupAddMemberOptionalArgs{}
+
+	args := ipa.NetgroupAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+		id = fmt.Sprintf("%s/u/%s", encodeSlash(data.Name.ValueString()), data.User.ValueString())
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+		id = fmt.Sprintf("%s/g/%s", encodeSlash(data.Name.ValueString()), data.Group.ValueString())
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+		id = fmt.Sprintf("%s/h/%s", encodeSlash(data.Name.ValueString()), data.Host.ValueString())
+	}
+	if !data.Hostgroup.IsNull() {
+		v := []string{data.Hostgroup.ValueString()}
+		optArgs.Hostgroup = &v
+		id = fmt.Sprintf("%s/hg/%s", encodeSlash(data.Name.ValueString()), data.Hostgroup.ValueString())
+	}
+	if !data.Netgroup.IsNull() {
+		v := []string{data.Netgroup.ValueString()}
+		optArgs.Netgroup = &v
+		id = fmt.Sprintf("%s/n/%s", encodeSlash(data.Name.ValueString()), data.Netgroup.ValueString())
+	}
+	if !data.Users.IsNull() || !data.Groups.IsNull() || !data.Hosts.IsNull() || !data.Hostgroups.IsNull() || !data.Netgroups.IsNull() {
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+		if !data.Netgroups.IsNull() {
+			v := listStringValues(data.Netgroups)
+			optArgs.Netgroup = &v
+		}
+		id = fmt.Sprintf("%s/m/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.NetgroupAddMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa netgroup membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa netgroup membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetgroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	netgroupId, typeId, memberId, err := parseNetgroupMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_netgroup_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.NetgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.NetgroupShowArgs{
+		Cn: netgroupId,
+	}
+
+	res, err := r.client.NetgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Netgroup not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa netgroup: %s", err))
+			return
+		}
+	}
+
+	// Hosts unknown to FreeIPA are stored as external hosts.
+	var hosts []string
+	if res.Result.MemberhostHost != nil {
+		hosts = append(hosts, *res.Result.MemberhostHost...)
+	}
+	if res.Result.Externalhost != nil {
+		hosts = append(hosts, *res.Result.Externalhost...)
+	}
+
+	switch typeId {
+	case "u":
+		if res.Result.MemberuserUser == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserUser, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Netgroup user membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "g":
+		if res.Result.MemberuserGroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserGroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Netgroup group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "h":
+		if !isStringListContainsCaseInsensistive(&hosts, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Netgroup host membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "hg":
+		if res.Result.MemberhostHostgroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberhostHostgroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Netgroup host group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "n":
+		if res.Result.MemberNetgroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberNetgroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Netgroup nested netgroup membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "m":
+		var diag diag.Diagnostics
+		if !data.Users.IsNull() {
+			data.Users, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Users, res.Result.MemberuserUser))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Groups.IsNull() {
+			data.Groups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Groups, res.Result.MemberuserGroup))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hosts.IsNull() {
+			data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hosts, &hosts))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hostgroups.IsNull() {
+			data.Hostgroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hostgroups, res.Result.MemberhostHostgroup))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Netgroups.IsNull() {
+			data.Netgroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Netgroups, res.Result.MemberNetgroup))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NetgroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NetgroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.NetgroupAddMemberOptionalArgs{}
+
+	memberAddArgs := ipa.NetgroupAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.NetgroupRemoveMemberOptionalArgs{}
+
+	memberDelArgs := ipa.NetgroupRemoveMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Users.Equal(state.Users) {
+		addedUsers, deletedUsers := diffStringLists(state.Users, data.Users)
+		if len(addedUsers) > 0 {
+			memberAddOptArgs.User = &addedUsers
+			hasMemberAdd = true
+		}
+		if len(deletedUsers) > 0 {
+			memberDelOptArgs.User = &deletedUsers
+			hasMemberDel = true
+		}
+	}
+	if !data.Groups.Equal(state.Groups) {
+		addedGroups, deletedGroups := diffStringLists(state.Groups, data.Groups)
+		if len(addedGroups) > 0 {
+			memberAddOptArgs.Group = &addedGroups
+			hasMemberAdd = true
+		}
+		if len(deletedGroups) > 0 {
+			memberDelOptArgs.Group = &deletedGroups
+			hasMemberDel = true
+		}
+	}
+	if !data.Hosts.Equal(state.Hosts) {
+		addedHosts, deletedHosts := diffStringLists(state.Hosts, data.Hosts)
+		if len(addedHosts) > 0 {
+			memberAddOptArgs.Host = &addedHosts
+			hasMemberAdd = true
+		}
+		if len(deletedHosts) > 0 {
+			memberDelOptArgs.Host = &deletedHosts
+			hasMemberDel = true
+		}
+	}
+	if !data.Hostgroups.Equal(state.Hostgroups) {
+		addedHostgroups, deletedHostgroups := diffStringLists(state.Hostgroups, data.Hostgroups)
+		if len(addedHostgroups) > 0 {
+			memberAddOptArgs.Hostgroup = &addedHostgroups
+			hasMemberAdd = true
+		}
+		if len(deletedHostgroups) > 0 {
+			memberDelOptArgs.Hostgroup = &deletedHostgroups
+			hasMemberDel = true
+		}
+	}
+	if !data.Netgroups.Equal(state.Netgroups) {
+		addedNetgroups, deletedNetgroups := diffStringLists(state.Netgroups, data.Netgroups)
+		if len(addedNetgroups) > 0 {
+			memberAddOptArgs.Netgroup = &addedNetgroups
+			hasMemberAdd = true
+		}
+		if len(deletedNetgroups) > 0 {
+			memberDelOptArgs.Netgroup = &deletedNetgroups
+			hasMemberDel = true
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.NetgroupAddMember(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa netgroup membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa netgroup membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa netgroup membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.NetgroupRemoveMember(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa netgroup membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa netgroup membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa netgroup membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetgroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	netgroupId, typeId, memberId, err := parseNetgroupMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_netgroup_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.NetgroupRemoveMemberOptionalArgs{}
+
+	args := ipa.NetgroupRemoveMemberArgs{
+		Cn: netgroupId,
+	}
+
+	switch typeId {
+	case "u":
+		v := []string{memberId}
+		optArgs.User = &v
+	case "g":
+		v := []string{memberId}
+		optArgs.Group = &v
+	case "h":
+		v := []string{memberId}
+		optArgs.Host = &v
+	case "hg":
+		v := []string{memberId}
+		optArgs.Hostgroup = &v
+	case "n":
+		v := []string{memberId}
+		optArgs.Netgroup = &v
+	case "m":
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+		if !data.Netgroups.IsNull() {
+			v := listStringValues(data.Netgroups)
+			optArgs.Netgroup = &v
+		}
+	}
+
+	_, err = r.client.NetgroupRemoveMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa netgroup membership: %s", err))
+		return
+	}
+}
+
+func (r *NetgroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	netgroupId, typeId, memberId, err := parseNetgroupMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.NetgroupShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.NetgroupShowArgs{
+		Cn: netgroupId,
+	}
+
+	res, err := r.client.NetgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Netgroup not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa netgroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), netgroupId)...)
+
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "n":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("netgroup"), memberId)...)
+	case "m":
+		// The imported membership manages all the current members of the netgroup.
+		var users, groups, hosts, hostgroups, netgroups []string
+		if res.Result.MemberuserUser != nil {
+			users = *res.Result.MemberuserUser
+		}
+		if res.Result.MemberuserGroup != nil {
+			groups = *res.Result.MemberuserGroup
+		}
+		if res.Result.MemberhostHost != nil {
+			hosts = append(hosts, *res.Result.MemberhostHost...)
+		}
+		if res.Result.Externalhost != nil {
+			hosts = append(hosts, *res.Result.Externalhost...)
+		}
+		if res.Result.MemberhostHostgroup != nil {
+			hostgroups = *res.Result.MemberhostHostgroup
+		}
+		if res.Result.MemberNetgroup != nil {
+			netgroups = *res.Result.MemberNetgroup
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), users)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), groups)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), hosts)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroups"), hostgroups)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("netgroups"), netgroups)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseNetgroupMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine netgroup membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	member := idParts[2]
+
+	return name, _type, member, nil
+}
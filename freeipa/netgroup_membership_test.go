@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPANetgroupMembership_simple(t *testing.T) {
+	testNetgroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-netgroup\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_netgroup.netgroup-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testExternalMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_netgroup.netgroup-0.name",
+		"host":  "\"nfs-client-1.legacy.lan\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPANetgroupMembership_resource(testMembership) + testAccFreeIPANetgroupMembership_resource(testExternalMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "name", "testacc-netgroup"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "user", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-1", "host", "nfs-client-1.legacy.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPANetgroupMembership_resource(testMembership) + testAccFreeIPANetgroupMembership_resource(testExternalMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPANetgroupMembership_multiple(t *testing.T) {
+	testNetgroup0 := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-netgroup\"",
+	}
+	testNetgroup1 := map[string]string{
+		"index": "1",
+		"name":  "\"testacc-netgroup-nested\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"identifier": "\"members-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_netgroup.netgroup-0.name",
+		"groups":     "[freeipa_group.group-0.name]",
+		"hosts":      "[freeipa_host.host-0.name, \"nfs-client-1.legacy.lan\"]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"netgroups":  "[freeipa_netgroup.netgroup-1.name]",
+		"identifier": "\"members-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup0) + testAccFreeIPANetgroup_resource(testNetgroup1) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPANetgroupMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "name", "testacc-netgroup"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "hosts.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup0) + testAccFreeIPANetgroup_resource(testNetgroup1) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPANetgroupMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "users"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "hosts.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "hostgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_netgroup_membership.netgroup-membership-0", "netgroups.0", "testacc-netgroup-nested"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup0) + testAccFreeIPANetgroup_resource(testNetgroup1) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPANetgroupMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetgroupResource{}
+var _ resource.ResourceWithImportState = &NetgroupResource{}
+
+func NewNetgroupResource() resource.Resource {
+	return &NetgroupResource{}
+}
+
+// NetgroupResource defines the resource implementation.
+type NetgroupResource struct {
+	client *ipa.Client
+}
+
+// NetgroupResourceModel describes the resource data model.
+type NetgroupResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	NisDomain     types.String `tfsdk:"nisdomain"`
+	UserCategory  types.String `tfsdk:"usercategory"`
+	HostCategory  types.String `tfsdk:"hostcategory"`
+	ExternalHosts types.List   `tfsdk:"external_hosts"`
+}
+
+func (r *NetgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_netgroup"
+}
+
+func (r *NetgroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *NetgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA netgroup resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the netgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Netgroup description",
+				Optional:            true,
+			},
+			"nisdomain": schema.StringAttribute{
+				MarkdownDescription: "NIS domain name of the netgroup. FreeIPA uses the IPA domain when not set.",
+				Optional:            true,
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the netgroup is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the netgroup is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"external_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts unknown to FreeIPA to add to the netgroup",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *NetgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.NetgroupAddOptionalArgs{}
+
+	args := ipa.NetgroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.NisDomain.IsNull() {
+		optArgs.Nisdomainname = data.NisDomain.ValueStringPointer()
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+	}
+	// External hosts are not exposed as an option of the API, they are stored with the generic attribute setter.
+	for _, host := range listStringValues(data.ExternalHosts) {
+		optArgs.Addattr = appendSetattr(optArgs.Addattr, "externalhost="+host)
+	}
+	_, err := r.client.NetgroupAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa netgroup: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.NetgroupShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.NetgroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	res, err := r.client.NetgroupShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Netgroup not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa netgroup: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa netgroup %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa netgroup %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Nisdomainname != nil && !data.NisDomain.IsNull() {
+		data.NisDomain = types.StringValue(*res.Result.Nisdomainname)
+	}
+	if res.Result.Usercategory != nil && !data.UserCategory.IsNull() {
+		data.UserCategory = types.StringValue(*res.Result.Usercategory)
+	}
+	if res.Result.Hostcategory != nil && !data.HostCategory.IsNull() {
+		data.HostCategory = types.StringValue(*res.Result.Hostcategory)
+	}
+	if !data.ExternalHosts.IsNull() {
+		data.ExternalHosts, _ = types.ListValueFrom(ctx, types.StringType, filterStringList(data.ExternalHosts, res.Result.Externalhost))
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NetgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state NetgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.NetgroupModArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.NetgroupModOptionalArgs{}
+
+	var hasChange = false
+	empty := ""
+
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = &empty
+		if !data.Description.IsNull() {
+			optArgs.Description = data.Description.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.NisDomain.Equal(state.NisDomain) {
+		optArgs.Nisdomainname = &empty
+		if !data.NisDomain.IsNull() {
+			optArgs.Nisdomainname = data.NisDomain.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.UserCategory.Equal(state.UserCategory) {
+		optArgs.Usercategory = &empty
+		if !data.UserCategory.IsNull() {
+			optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HostCategory.Equal(state.HostCategory) {
+		optArgs.Hostcategory = &empty
+		if !data.HostCategory.IsNull() {
+			optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.ExternalHosts.Equal(state.ExternalHosts) {
+		added, removed := diffStringLists(state.ExternalHosts, data.ExternalHosts)
+		for _, host := range added {
+			optArgs.Addattr = appendSetattr(optArgs.Addattr, "externalhost="+host)
+		}
+		for _, host := range removed {
+			optArgs.Delattr = appendSetattr(optArgs.Delattr, "externalhost="+host)
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.NetgroupMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa netgroup: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa netgroup %s", data.Name.ValueString()))
+	args := ipa.NetgroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.NetgroupDel(&args, &ipa.NetgroupDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Netgroup %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *NetgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPANetgroup_full(t *testing.T) {
+	testNetgroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-netgroup\"",
+	}
+	testNetgroupModified := map[string]string{
+		"index":          "0",
+		"name":           "\"testacc-netgroup\"",
+		"description":    "\"A netgroup for acceptance tests\"",
+		"nisdomain":      "\"testacc.nis\"",
+		"usercategory":   "\"all\"",
+		"external_hosts": "[\"nfs-client-1.legacy.lan\", \"nfs-client-2.legacy.lan\"]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "name", "testacc-netgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroupModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "description", "A netgroup for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "nisdomain", "testacc.nis"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "usercategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_netgroup.netgroup-0", "external_hosts.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPANetgroup_resource(testNetgroupModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
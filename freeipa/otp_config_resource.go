@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// globalOtpConfig is the ID of the OTP configuration, there is only one for the whole FreeIPA domain.
+const globalOtpConfig = "global"
+
+// Default OTP synchronization windows of FreeIPA, restored when the resource is destroyed.
+const (
+	defaultTotpAuthWindow = 300
+	defaultTotpSyncWindow = 86400
+	defaultHotpAuthWindow = 10
+	defaultHotpSyncWindow = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OtpConfigResource{}
+var _ resource.ResourceWithImportState = &OtpConfigResource{}
+
+func NewOtpConfigResource() resource.Resource {
+	return &OtpConfigResource{}
+}
+
+// OtpConfigResource defines the resource implementation.
+type OtpConfigResource struct {
+	client *ipa.Client
+}
+
+// OtpConfigResourceModel describes the resource data model.
+type OtpConfigResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	TotpAuthWindow types.Int64  `tfsdk:"totp_auth_window"`
+	TotpSyncWindow types.Int64  `tfsdk:"totp_sync_window"`
+	HotpAuthWindow types.Int64  `tfsdk:"hotp_auth_window"`
+	HotpSyncWindow types.Int64  `tfsdk:"hotp_sync_window"`
+}
+
+func (r *OtpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_otp_config"
+}
+
+func (r *OtpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA OTP configuration resource.\nManages the global TOTP and HOTP authentication and synchronization windows. The FreeIPA defaults are restored on destruction.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, always `global`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_auth_window": schema.Int64Attribute{
+				MarkdownDescription: "TOTP authentication time variance (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"totp_sync_window": schema.Int64Attribute{
+				MarkdownDescription: "TOTP synchronization time variance (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"hotp_auth_window": schema.Int64Attribute{
+				MarkdownDescription: "HOTP authentication skip-ahead (in number of codes)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"hotp_sync_window": schema.Int64Attribute{
+				MarkdownDescription: "HOTP synchronization skip-ahead (in number of codes)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OtpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OtpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OtpConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The OTP configuration always exists, it is only modified.
+	optArgs := ipa.OtpconfigModOptionalArgs{
+		Ipatokentotpauthwindow: intPointer(data.TotpAuthWindow),
+		Ipatokentotpsyncwindow: intPointer(data.TotpSyncWindow),
+		Ipatokenhotpauthwindow: intPointer(data.HotpAuthWindow),
+		Ipatokenhotpsyncwindow: intPointer(data.HotpSyncWindow),
+	}
+	_, err := r.client.OtpconfigMod(&ipa.OtpconfigModArgs{}, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa otp configuration: %s", err))
+		return
+	}
+	data.Id = types.StringValue(globalOtpConfig)
+
+	// The attributes not set in the configuration are computed by FreeIPA.
+	r.readOtpConfig(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OtpConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readOtpConfig(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OtpConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.OtpconfigModOptionalArgs{}
+
+	if !data.TotpAuthWindow.Equal(state.TotpAuthWindow) {
+		optArgs.Ipatokentotpauthwindow = intPointer(data.TotpAuthWindow)
+	}
+	if !data.TotpSyncWindow.Equal(state.TotpSyncWindow) {
+		optArgs.Ipatokentotpsyncwindow = intPointer(data.TotpSyncWindow)
+	}
+	if !data.HotpAuthWindow.Equal(state.HotpAuthWindow) {
+		optArgs.Ipatokenhotpauthwindow = intPointer(data.HotpAuthWindow)
+	}
+	if !data.HotpSyncWindow.Equal(state.HotpSyncWindow) {
+		optArgs.Ipatokenhotpsyncwindow = intPointer(data.HotpSyncWindow)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa otp configuration from plan = %v", data))
+	_, err := r.client.OtpconfigMod(&ipa.OtpconfigModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", "EmptyModlist (4202): no modifications to be performed on otp configuration")
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa otp configuration: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "[DEBUG] Reset freeipa otp configuration")
+	totpAuthWindow := defaultTotpAuthWindow
+	totpSyncWindow := defaultTotpSyncWindow
+	hotpAuthWindow := defaultHotpAuthWindow
+	hotpSyncWindow := defaultHotpSyncWindow
+	optArgs := ipa.OtpconfigModOptionalArgs{
+		Ipatokentotpauthwindow: &totpAuthWindow,
+		Ipatokentotpsyncwindow: &totpSyncWindow,
+		Ipatokenhotpauthwindow: &hotpAuthWindow,
+		Ipatokenhotpsyncwindow: &hotpSyncWindow,
+	}
+	_, err := r.client.OtpconfigMod(&ipa.OtpconfigModArgs{}, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] OTP configuration reset failed: %s", err))
+		return
+	}
+}
+
+func (r *OtpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != globalOtpConfig {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The import id of the otp configuration must be %s, got %s", globalOtpConfig, req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// readOtpConfig updates the model with the OTP configuration read from FreeIPA.
+func (r *OtpConfigResource) readOtpConfig(ctx context.Context, data *OtpConfigResourceModel, diags *diag.Diagnostics) {
+	all := true
+	optArgs := ipa.OtpconfigShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.OtpconfigShow(&ipa.OtpconfigShowArgs{}, &optArgs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa otp configuration: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa otp configuration %s", res.Result.String()))
+
+	data.TotpAuthWindow = int64Value(res.Result.Ipatokentotpauthwindow)
+	data.TotpSyncWindow = int64Value(res.Result.Ipatokentotpsyncwindow)
+	data.HotpAuthWindow = int64Value(res.Result.Ipatokenhotpauthwindow)
+	data.HotpSyncWindow = int64Value(res.Result.Ipatokenhotpsyncwindow)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAOtpConfig_full(t *testing.T) {
+	testOtpConfig := map[string]string{
+		"index":            "0",
+		"totp_auth_window": "600",
+	}
+	testOtpConfigModified := map[string]string{
+		"index":            "0",
+		"totp_auth_window": "300",
+		"totp_sync_window": "43200",
+		"hotp_auth_window": "20",
+		"hotp_sync_window": "200",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAOtpConfig_resource(testOtpConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "id", "global"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "totp_auth_window", "600"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "totp_sync_window", "86400"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "hotp_auth_window", "10"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "hotp_sync_window", "100"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAOtpConfig_resource(testOtpConfig),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAOtpConfig_resource(testOtpConfigModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "totp_auth_window", "300"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "totp_sync_window", "43200"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "hotp_auth_window", "20"),
+					resource.TestCheckResourceAttr("freeipa_otp_config.otp-config-0", "hotp_sync_window", "200"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAOtpConfig_resource(testOtpConfigModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
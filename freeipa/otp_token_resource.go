@@ -0,0 +1,498 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OtpTokenResource{}
+var _ resource.ResourceWithImportState = &OtpTokenResource{}
+
+func NewOtpTokenResource() resource.Resource {
+	return &OtpTokenResource{}
+}
+
+// OtpTokenResource defines the resource implementation.
+type OtpTokenResource struct {
+	client *ipa.Client
+}
+
+// OtpTokenResourceModel describes the resource data model.
+type OtpTokenResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	UniqueId    types.String `tfsdk:"unique_id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Owner       types.String `tfsdk:"owner"`
+	Managers    types.List   `tfsdk:"managers"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	Algorithm   types.String `tfsdk:"algorithm"`
+	Digits      types.Int64  `tfsdk:"digits"`
+	Interval    types.Int64  `tfsdk:"interval"`
+	Uri         types.String `tfsdk:"uri"`
+}
+
+func (r *OtpTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_otp_token"
+}
+
+func (r *OtpTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA OTP token resource.\nThe secret of the token is generated by FreeIPA and only returned at creation, within the `uri` attribute. An imported token has no `uri`.\nThe token is only replaced when `algorithm`, `digits` or `interval` are changed in the configuration, removing them keeps the token unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unique_id": schema.StringAttribute{
+				MarkdownDescription: "Unique ID of the token. Generated by FreeIPA when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the token (`totp` or `hotp`, default to `totp`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("totp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("totp", "hotp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Token description",
+				Optional:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the token",
+				Optional:            true,
+			},
+			"managers": schema.ListAttribute{
+				MarkdownDescription: "List of users allowed to manage the token",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Disable the token",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "Date the token becomes valid (RFC3339 format, ex: `2025-01-31T00:00:00Z`)",
+				Optional:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "Date the token expires (RFC3339 format, ex: `2026-01-31T00:00:00Z`)",
+				Optional:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "Token hash algorithm (`sha1`, `sha256`, `sha384` or `sha512`). FreeIPA uses `sha1` when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("sha1", "sha256", "sha384", "sha512"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"digits": schema.Int64Attribute{
+				MarkdownDescription: "Number of digits of the generated codes (`6` or `8`). FreeIPA uses `6` when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(6, 8),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: "Length of the time step of a TOTP token (in seconds). FreeIPA uses `30` when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "`otpauth://` URI of the token, containing its secret, to be loaded in an authenticator",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OtpTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OtpTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disabled := data.Disabled.ValueBool()
+	optArgs := ipa.OtptokenAddOptionalArgs{
+		Type:                 data.Type.ValueStringPointer(),
+		Description:          data.Description.ValueStringPointer(),
+		Ipatokenowner:        data.Owner.ValueStringPointer(),
+		Ipatokendisabled:     &disabled,
+		Ipatokenotpalgorithm: data.Algorithm.ValueStringPointer(),
+		Ipatokenotpdigits:    intPointer(data.Digits),
+		Ipatokentotptimestep: intPointer(data.Interval),
+	}
+	if !data.UniqueId.IsNull() && !data.UniqueId.IsUnknown() {
+		optArgs.Ipatokenuniqueid = data.UniqueId.ValueStringPointer()
+	}
+	optArgs.Ipatokennotbefore = parseOtpTokenDate(data.NotBefore, "not_before", &resp.Diagnostics)
+	optArgs.Ipatokennotafter = parseOtpTokenDate(data.NotAfter, "not_after", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.OtptokenAdd(&ipa.OtptokenAddArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa otp token: %s", err))
+		return
+	}
+	data.UniqueId = types.StringValue(res.Result.Ipatokenuniqueid)
+	data.Id = data.UniqueId
+	data.Uri = types.StringPointerValue(res.Result.Uri)
+
+	if !data.Managers.IsNull() {
+		v := listStringValues(data.Managers)
+		_v, err := r.client.OtptokenAddManagedby(&ipa.OtptokenAddManagedbyArgs{Ipatokenuniqueid: data.UniqueId.ValueString()}, &ipa.OtptokenAddManagedbyOptionalArgs{User: &v})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding freeipa otp token managers: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning adding freeipa otp token managers: %v", _v.Failed))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.OtptokenShowArgs{
+		Ipatokenuniqueid: data.UniqueId.ValueString(),
+	}
+	optArgs := ipa.OtptokenShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.OtptokenShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] OTP token not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa otp token: %s", err))
+			return
+		}
+	}
+
+	data.Id = data.UniqueId
+	// FreeIPA displays the token type in upper case.
+	if res.Result.Type != nil {
+		data.Type = types.StringValue(strings.ToLower(*res.Result.Type))
+	}
+	if res.Result.Ipatokendisabled != nil {
+		data.Disabled = types.BoolValue(*res.Result.Ipatokendisabled)
+	} else {
+		data.Disabled = types.BoolValue(false)
+	}
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipatokenowner != nil && !data.Owner.IsNull() {
+		data.Owner = types.StringValue(*res.Result.Ipatokenowner)
+	}
+	if !data.Managers.IsNull() {
+		data.Managers, _ = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Managers, res.Result.ManagedbyUser))
+	}
+	if res.Result.Ipatokennotbefore != nil && !data.NotBefore.IsNull() {
+		data.NotBefore = otpTokenDateValue(data.NotBefore, *res.Result.Ipatokennotbefore)
+	}
+	if res.Result.Ipatokennotafter != nil && !data.NotAfter.IsNull() {
+		data.NotAfter = otpTokenDateValue(data.NotAfter, *res.Result.Ipatokennotafter)
+	}
+	if res.Result.Ipatokenotpalgorithm != nil && !data.Algorithm.IsNull() {
+		data.Algorithm = types.StringValue(*res.Result.Ipatokenotpalgorithm)
+	}
+	if res.Result.Ipatokenotpdigits != nil && !data.Digits.IsNull() {
+		data.Digits = int64Value(res.Result.Ipatokenotpdigits)
+	}
+	if res.Result.Ipatokentotptimestep != nil && !data.Interval.IsNull() {
+		data.Interval = int64Value(res.Result.Ipatokentotptimestep)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OtpTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.OtptokenModArgs{
+		Ipatokenuniqueid: data.UniqueId.ValueString(),
+	}
+	optArgs := ipa.OtptokenModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+		hasChange = true
+	}
+	if !data.Owner.Equal(state.Owner) {
+		if data.Owner.ValueStringPointer() != nil {
+			optArgs.Ipatokenowner = data.Owner.ValueStringPointer()
+		} else {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipatokenowner=")
+		}
+		hasChange = true
+	}
+	if !data.Disabled.Equal(state.Disabled) {
+		v := data.Disabled.ValueBool()
+		optArgs.Ipatokendisabled = &v
+		hasChange = true
+	}
+	if !data.NotBefore.Equal(state.NotBefore) {
+		if data.NotBefore.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipatokennotbefore=")
+		} else {
+			optArgs.Ipatokennotbefore = parseOtpTokenDate(data.NotBefore, "not_before", &resp.Diagnostics)
+		}
+		hasChange = true
+	}
+	if !data.NotAfter.Equal(state.NotAfter) {
+		if data.NotAfter.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipatokennotafter=")
+		} else {
+			optArgs.Ipatokennotafter = parseOtpTokenDate(data.NotAfter, "not_after", &resp.Diagnostics)
+		}
+		hasChange = true
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hasChange {
+		_, err := r.client.OtptokenMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa otp token: %s", err))
+				return
+			}
+		}
+	}
+
+	// Managers can be added or removed, comparing the current state and the plan allows us to define 2 lists of managers to add or remove.
+	if !data.Managers.Equal(state.Managers) {
+		addedManagers, removedManagers := diffStringLists(state.Managers, data.Managers)
+		if len(addedManagers) > 0 {
+			_v, err := r.client.OtptokenAddManagedby(&ipa.OtptokenAddManagedbyArgs{Ipatokenuniqueid: data.UniqueId.ValueString()}, &ipa.OtptokenAddManagedbyOptionalArgs{User: &addedManagers})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding freeipa otp token managers: %s", err))
+				return
+			}
+			if _v.Completed == 0 {
+				resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning adding freeipa otp token managers: %v", _v.Failed))
+			}
+		}
+		if len(removedManagers) > 0 {
+			_v, err := r.client.OtptokenRemoveManagedby(&ipa.OtptokenRemoveManagedbyArgs{Ipatokenuniqueid: data.UniqueId.ValueString()}, &ipa.OtptokenRemoveManagedbyOptionalArgs{User: &removedManagers})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa otp token managers: %s", err))
+				return
+			}
+			if _v.Completed == 0 {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa otp token managers: %v", _v.Failed))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OtpTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OtpTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.OtptokenDelArgs{
+		Ipatokenuniqueid: []string{data.UniqueId.ValueString()},
+	}
+	_, err := r.client.OtptokenDel(&args, &ipa.OtptokenDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa otp token: %s", err))
+		return
+	}
+}
+
+func (r *OtpTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	args := ipa.OtptokenShowArgs{
+		Ipatokenuniqueid: req.ID,
+	}
+	optArgs := ipa.OtptokenShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.OtptokenShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("OTP token %s not found", req.ID))
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa otp token: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("unique_id"), req.ID)...)
+	if res.Result.Description != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), res.Result.Description)...)
+	}
+	if res.Result.Ipatokenowner != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), res.Result.Ipatokenowner)...)
+	}
+	if res.Result.ManagedbyUser != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("managers"), res.Result.ManagedbyUser)...)
+	}
+	if res.Result.Ipatokennotbefore != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("not_before"), res.Result.Ipatokennotbefore.UTC().Format(time.RFC3339))...)
+	}
+	if res.Result.Ipatokennotafter != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("not_after"), res.Result.Ipatokennotafter.UTC().Format(time.RFC3339))...)
+	}
+	if res.Result.Ipatokenotpalgorithm != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("algorithm"), res.Result.Ipatokenotpalgorithm)...)
+	}
+	if res.Result.Ipatokenotpdigits != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("digits"), int64Value(res.Result.Ipatokenotpdigits))...)
+	}
+	if res.Result.Ipatokentotptimestep != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interval"), int64Value(res.Result.Ipatokentotptimestep))...)
+	}
+}
+
+// parseOtpTokenDate returns the timestamp of a RFC3339 date attribute, or nil when the attribute is not set.
+func parseOtpTokenDate(value types.String, attribute string, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddError("Attribute format", fmt.Sprintf("The %s timestamp could not be parsed as RFC3339: %s", attribute, err))
+		return nil
+	}
+	return &timestamp
+}
+
+// otpTokenDateValue keeps the date of the state when it matches the timestamp read from FreeIPA,
+// FreeIPA returning the dates in UTC whatever the timezone used in the configuration.
+func otpTokenDateValue(value types.String, timestamp time.Time) types.String {
+	current, err := time.Parse(time.RFC3339, value.ValueString())
+	if err == nil && current.Equal(timestamp) {
+		return value
+	}
+	return types.StringValue(timestamp.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAOtpToken_totp(t *testing.T) {
+	testUser0 := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testUser1 := map[string]string{
+		"index":     "1",
+		"login":     "\"testacc-user-1\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User1\"",
+	}
+	testOtpToken := map[string]string{
+		"index":     "0",
+		"unique_id": "\"testacc-token-0\"",
+		"owner":     "freeipa_user.user-0.name",
+		"algorithm": "\"sha256\"",
+		"digits":    "8",
+		"interval":  "60",
+	}
+	testOtpTokenModified := map[string]string{
+		"index":       "0",
+		"unique_id":   "\"testacc-token-0\"",
+		"owner":       "freeipa_user.user-0.name",
+		"algorithm":   "\"sha256\"",
+		"digits":      "8",
+		"interval":    "60",
+		"description": "\"An OTP token for acceptance tests\"",
+		"managers":    "[freeipa_user.user-1.name]",
+		"disabled":    "true",
+		"not_before":  "\"2025-01-01T00:00:00Z\"",
+		"not_after":   "\"2035-01-01T00:00:00Z\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAOtpToken_resource(testOtpToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "unique_id", "testacc-token-0"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "type", "totp"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "owner", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "disabled", "false"),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "uri", regexp.MustCompile("^otpauth://totp/.*algorithm=SHA256.*")),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAOtpToken_resource(testOtpToken),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAOtpToken_resource(testOtpTokenModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "description", "An OTP token for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "managers.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "managers.0", "testacc-user-1"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "disabled", "true"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "not_before", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "not_after", "2035-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("freeipa_otp_token.otp-token-0", "uri"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser0) + testAccFreeIPAUser_resource(testUser1) + testAccFreeIPAOtpToken_resource(testOtpTokenModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      "freeipa_otp_token.otp-token-0",
+				ImportState:       true,
+				ImportStateId:     "testacc-token-0",
+				ImportStateVerify: true,
+				// The secret is not returned by FreeIPA, and the owner is also a manager of the token.
+				ImportStateVerifyIgnore: []string{"uri", "managers"},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAOtpToken_hotp(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testOtpToken := map[string]string{
+		"index": "0",
+		"type":  "\"hotp\"",
+		"owner": "freeipa_user.user-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAOtpToken_resource(testOtpToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("freeipa_otp_token.otp-token-0", "unique_id"),
+					resource.TestCheckResourceAttr("freeipa_otp_token.otp-token-0", "type", "hotp"),
+					resource.TestMatchResourceAttr("freeipa_otp_token.otp-token-0", "uri", regexp.MustCompile("^otpauth://hotp/")),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAOtpToken_resource(testOtpToken),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
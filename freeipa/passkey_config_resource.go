@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// globalPasskeyConfig is the ID of the passkey configuration, there is only one for the whole FreeIPA domain.
+const globalPasskeyConfig = "global"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PasskeyConfigResource{}
+var _ resource.ResourceWithImportState = &PasskeyConfigResource{}
+
+func NewPasskeyConfigResource() resource.Resource {
+	return &PasskeyConfigResource{}
+}
+
+// PasskeyConfigResource defines the resource implementation.
+type PasskeyConfigResource struct {
+	client *ipa.Client
+}
+
+// PasskeyConfigResourceModel describes the resource data model.
+type PasskeyConfigResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	RequireUserVerification types.Bool   `tfsdk:"require_user_verification"`
+}
+
+func (r *PasskeyConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_passkey_config"
+}
+
+func (r *PasskeyConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA passkey configuration resource.\nManages the global passkey authentication settings. The FreeIPA defaults are restored on destruction.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource, always `global`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_user_verification": schema.BoolAttribute{
+				MarkdownDescription: "Require user verification (PIN or biometrics) during passkey authentication",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PasskeyConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasskeyConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PasskeyConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The passkey configuration always exists, it is only modified.
+	optArgs := ipa.PasskeyconfigModOptionalArgs{
+		Iparequireuserverification: data.RequireUserVerification.ValueBoolPointer(),
+	}
+	_, err := r.client.PasskeyconfigMod(&ipa.PasskeyconfigModArgs{}, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa passkey configuration: %s", err))
+		return
+	}
+	data.Id = types.StringValue(globalPasskeyConfig)
+
+	// The attributes not set in the configuration are computed by FreeIPA.
+	r.readPasskeyConfig(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasskeyConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PasskeyConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readPasskeyConfig(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasskeyConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PasskeyConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PasskeyconfigModOptionalArgs{}
+
+	if !data.RequireUserVerification.Equal(state.RequireUserVerification) {
+		optArgs.Iparequireuserverification = data.RequireUserVerification.ValueBoolPointer()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa passkey configuration from plan = %v", data))
+	_, err := r.client.PasskeyconfigMod(&ipa.PasskeyconfigModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", "EmptyModlist (4202): no modifications to be performed on passkey configuration")
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa passkey configuration: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasskeyConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "[DEBUG] Reset freeipa passkey configuration")
+	// User verification is required by default in FreeIPA.
+	requireUserVerification := true
+	optArgs := ipa.PasskeyconfigModOptionalArgs{
+		Iparequireuserverification: &requireUserVerification,
+	}
+	_, err := r.client.PasskeyconfigMod(&ipa.PasskeyconfigModArgs{}, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Passkey configuration reset failed: %s", err))
+		return
+	}
+}
+
+func (r *PasskeyConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != globalPasskeyConfig {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("The import id of the passkey configuration must be %s, got %s", globalPasskeyConfig, req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// readPasskeyConfig updates the model with the passkey configuration read from FreeIPA.
+func (r *PasskeyConfigResource) readPasskeyConfig(ctx context.Context, data *PasskeyConfigResourceModel, diags *diag.Diagnostics) {
+	all := true
+	optArgs := ipa.PasskeyconfigShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.PasskeyconfigShow(&ipa.PasskeyconfigShowArgs{}, &optArgs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa passkey configuration: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa passkey configuration %s", res.Result.String()))
+
+	data.RequireUserVerification = types.BoolValue(res.Result.Iparequireuserverification)
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPasskeyConfig_full(t *testing.T) {
+	testPasskeyConfig := map[string]string{
+		"index": "0",
+	}
+	testPasskeyConfigModified := map[string]string{
+		"index":                     "0",
+		"require_user_verification": "false",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasskeyConfig_resource(testPasskeyConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_passkey_config.passkey-config-0", "id", "global"),
+					resource.TestCheckResourceAttr("freeipa_passkey_config.passkey-config-0", "require_user_verification", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasskeyConfig_resource(testPasskeyConfig),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasskeyConfig_resource(testPasskeyConfigModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_passkey_config.passkey-config-0", "require_user_verification", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPasskeyConfig_resource(testPasskeyConfigModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PasswordPolicyDataSource{}
+var _ datasource.DataSourceWithConfigure = &PasswordPolicyDataSource{}
+
+func NewPasswordPolicyDataSource() datasource.DataSource {
+	return &PasswordPolicyDataSource{}
+}
+
+// PasswordPolicyDataSource defines the data source implementation.
+type PasswordPolicyDataSource struct {
+	client *ipa.Client
+}
+
+// PasswordPolicyDataSourceModel describes the data source data model.
+type PasswordPolicyDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	User            types.String `tfsdk:"user"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	MaxLifetime     types.Int64  `tfsdk:"max_lifetime"`
+	MinLifetime     types.Int64  `tfsdk:"min_lifetime"`
+	HistorySize     types.Int64  `tfsdk:"history_size"`
+	MinClasses      types.Int64  `tfsdk:"min_classes"`
+	MinLength       types.Int64  `tfsdk:"min_length"`
+	MaxFailures     types.Int64  `tfsdk:"max_failures"`
+	FailureInterval types.Int64  `tfsdk:"failure_interval"`
+	LockoutTime     types.Int64  `tfsdk:"lockout_time"`
+	GraceLimit      types.Int64  `tfsdk:"grace_limit"`
+	MaxRepeat       types.Int64  `tfsdk:"max_repeat"`
+	MaxSequence     types.Int64  `tfsdk:"max_sequence"`
+	DictionaryCheck types.Bool   `tfsdk:"dictionary_check"`
+	UserCheck       types.Bool   `tfsdk:"user_check"`
+}
+
+func (r *PasswordPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("user"),
+		),
+	}
+}
+
+func (r *PasswordPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA password policy data source.\nReturns either the password policy of a group, or the effective password policy of a user.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user group the password policy applies to, or `global_policy` for the global password policy. When `user` is set, name of the password policy applying to the user.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user to read the effective password policy of",
+				Optional:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the password policy",
+				Computed:            true,
+			},
+			"max_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum password lifetime (in days)",
+				Computed:            true,
+			},
+			"min_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Minimum password lifetime (in hours)",
+				Computed:            true,
+			},
+			"history_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of previous passwords that cannot be reused",
+				Computed:            true,
+			},
+			"min_classes": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of character classes in the password",
+				Computed:            true,
+			},
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "Minimum length of the password",
+				Computed:            true,
+			},
+			"max_failures": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive authentication failures before the account is locked",
+				Computed:            true,
+			},
+			"failure_interval": schema.Int64Attribute{
+				MarkdownDescription: "Period after which the authentication failure count is reset (in seconds)",
+				Computed:            true,
+			},
+			"lockout_time": schema.Int64Attribute{
+				MarkdownDescription: "Period during which a locked account stays locked (in seconds)",
+				Computed:            true,
+			},
+			"grace_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP authentications allowed once the password is expired",
+				Computed:            true,
+			},
+			"max_repeat": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of identical consecutive characters in the password",
+				Computed:            true,
+			},
+			"max_sequence": schema.Int64Attribute{
+				MarkdownDescription: "Maximum length of a monotonic character sequence in the password",
+				Computed:            true,
+			},
+			"dictionary_check": schema.BoolAttribute{
+				MarkdownDescription: "Passwords found in a dictionary are rejected",
+				Computed:            true,
+			},
+			"user_check": schema.BoolAttribute{
+				MarkdownDescription: "Passwords containing the user name are rejected",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasswordPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PasswordPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.PwpolicyShowOptionalArgs{
+		All: &all,
+	}
+	if !data.Name.IsNull() {
+		optArgs.Cn = data.Name.ValueStringPointer()
+	}
+	if !data.User.IsNull() {
+		optArgs.User = data.User.ValueStringPointer()
+	}
+
+	res, err := r.client.PwpolicyShow(&ipa.PwpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa password policy: %s", err))
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa password policy %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", "Error reading freeipa password policy")
+		return
+	}
+
+	if res.Result.Cn != nil {
+		data.Name = types.StringValue(*res.Result.Cn)
+	} else if data.Name.IsNull() {
+		data.Name = types.StringValue(globalPasswordPolicy)
+	}
+	data.Priority = int64Value(res.Result.Cospriority)
+	data.MaxLifetime = int64Value(res.Result.Krbmaxpwdlife)
+	data.MinLifetime = int64Value(res.Result.Krbminpwdlife)
+	data.HistorySize = int64Value(res.Result.Krbpwdhistorylength)
+	data.MinClasses = int64Value(res.Result.Krbpwdmindiffchars)
+	data.MinLength = int64Value(res.Result.Krbpwdminlength)
+	data.MaxFailures = int64Value(res.Result.Krbpwdmaxfailure)
+	data.FailureInterval = int64Value(res.Result.Krbpwdfailurecountinterval)
+	data.LockoutTime = int64Value(res.Result.Krbpwdlockoutduration)
+	data.GraceLimit = int64Value(res.Result.Passwordgracelimit)
+	data.MaxRepeat = int64Value(res.Result.Ipapwdmaxrepeat)
+	data.MaxSequence = int64Value(res.Result.Ipapwdmaxsequence)
+	data.DictionaryCheck = types.BoolPointerValue(res.Result.Ipapwddictcheck)
+	data.UserCheck = types.BoolPointerValue(res.Result.Ipapwdusercheck)
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,588 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// globalPasswordPolicy is the name of the password policy applying to the users without a group policy.
+const globalPasswordPolicy = "global_policy"
+
+// passwordPolicyDefaults is the global password policy of a new FreeIPA deployment.
+var passwordPolicyDefaults = PasswordPolicyResourceModel{
+	MaxLifetime:     types.Int64Value(90),
+	MinLifetime:     types.Int64Value(1),
+	HistorySize:     types.Int64Value(0),
+	MinClasses:      types.Int64Value(0),
+	MinLength:       types.Int64Value(8),
+	MaxFailures:     types.Int64Value(6),
+	FailureInterval: types.Int64Value(60),
+	LockoutTime:     types.Int64Value(600),
+	GraceLimit:      types.Int64Value(-1),
+	MaxRepeat:       types.Int64Value(0),
+	MaxSequence:     types.Int64Value(0),
+	DictionaryCheck: types.BoolValue(false),
+	UserCheck:       types.BoolValue(false),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PasswordPolicyResource{}
+var _ resource.ResourceWithImportState = &PasswordPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &PasswordPolicyResource{}
+
+func NewPasswordPolicyResource() resource.Resource {
+	return &PasswordPolicyResource{}
+}
+
+// PasswordPolicyResource defines the resource implementation.
+type PasswordPolicyResource struct {
+	client *ipa.Client
+}
+
+// PasswordPolicyResourceModel describes the resource data model.
+type PasswordPolicyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	MaxLifetime     types.Int64  `tfsdk:"max_lifetime"`
+	MinLifetime     types.Int64  `tfsdk:"min_lifetime"`
+	HistorySize     types.Int64  `tfsdk:"history_size"`
+	MinClasses      types.Int64  `tfsdk:"min_classes"`
+	MinLength       types.Int64  `tfsdk:"min_length"`
+	MaxFailures     types.Int64  `tfsdk:"max_failures"`
+	FailureInterval types.Int64  `tfsdk:"failure_interval"`
+	LockoutTime     types.Int64  `tfsdk:"lockout_time"`
+	GraceLimit      types.Int64  `tfsdk:"grace_limit"`
+	MaxRepeat       types.Int64  `tfsdk:"max_repeat"`
+	MaxSequence     types.Int64  `tfsdk:"max_sequence"`
+	DictionaryCheck types.Bool   `tfsdk:"dictionary_check"`
+	UserCheck       types.Bool   `tfsdk:"user_check"`
+}
+
+func (r *PasswordPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA password policy resource.\nThe global password policy is managed with the name `global_policy`, it is only modified on creation and reset to the defaults of a new FreeIPA deployment on destruction, not to its values before it was managed.\nThe attributes removed from the configuration are reset to their defaults on the global password policy, and unset on the group password policies.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user group the password policy applies to, or `global_policy` for the global password policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority of the password policy, the lower number having the higher priority. Required for the group password policies, not supported by the global one.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Maximum password lifetime (in days)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"min_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Minimum password lifetime (in hours)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"history_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of previous passwords that cannot be reused",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"min_classes": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of character classes (upper, lower, digits, special, non ASCII) in the password",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "Minimum length of the password",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"max_failures": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive authentication failures before the account is locked",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"failure_interval": schema.Int64Attribute{
+				MarkdownDescription: "Period after which the authentication failure count is reset (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"lockout_time": schema.Int64Attribute{
+				MarkdownDescription: "Period during which a locked account stays locked (in seconds)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"grace_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP authentications allowed once the password is expired. `-1` means unlimited, `0` disables the grace logins.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"max_repeat": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of identical consecutive characters in the password. `0` disables the check.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"max_sequence": schema.Int64Attribute{
+				MarkdownDescription: "Maximum length of a monotonic character sequence in the password (e.g. `abcd`). `0` disables the check.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateUnlessRemoved(),
+				},
+			},
+			"dictionary_check": schema.BoolAttribute{
+				MarkdownDescription: "Reject passwords found in a dictionary",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					useStateUnlessRemoved(),
+				},
+			},
+			"user_check": schema.BoolAttribute{
+				MarkdownDescription: "Reject passwords containing the user name",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					useStateUnlessRemoved(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Name.IsUnknown() || data.Priority.IsUnknown() {
+		return
+	}
+
+	if data.Name.ValueString() == globalPasswordPolicy {
+		if !data.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("priority"), "Invalid Attribute Combination", "The priority cannot be set on the global password policy")
+		}
+	} else if data.Priority.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("priority"), "Missing Attribute Configuration", fmt.Sprintf("The priority is required for the password policy of the group %s", data.Name.ValueString()))
+	}
+}
+
+func (r *PasswordPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasswordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if name == globalPasswordPolicy {
+		// The global password policy always exists, it is only modified.
+		optArgs := ipa.PwpolicyModOptionalArgs{
+			Cn:                         &name,
+			Krbmaxpwdlife:              intPointer(data.MaxLifetime),
+			Krbminpwdlife:              intPointer(data.MinLifetime),
+			Krbpwdhistorylength:        intPointer(data.HistorySize),
+			Krbpwdmindiffchars:         intPointer(data.MinClasses),
+			Krbpwdminlength:            intPointer(data.MinLength),
+			Krbpwdmaxfailure:           intPointer(data.MaxFailures),
+			Krbpwdfailurecountinterval: intPointer(data.FailureInterval),
+			Krbpwdlockoutduration:      intPointer(data.LockoutTime),
+			Passwordgracelimit:         intPointer(data.GraceLimit),
+			Ipapwdmaxrepeat:            intPointer(data.MaxRepeat),
+			Ipapwdmaxsequence:          intPointer(data.MaxSequence),
+		}
+		if !data.DictionaryCheck.IsUnknown() {
+			optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+		}
+		if !data.UserCheck.IsUnknown() {
+			optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+		}
+		_, err := r.client.PwpolicyMod(&ipa.PwpolicyModArgs{}, &optArgs)
+		if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa global password policy: %s", err))
+			return
+		}
+	} else {
+		optArgs := ipa.PwpolicyAddOptionalArgs{
+			Cn:                         &name,
+			Cospriority:                intPointer(data.Priority),
+			Krbmaxpwdlife:              intPointer(data.MaxLifetime),
+			Krbminpwdlife:              intPointer(data.MinLifetime),
+			Krbpwdhistorylength:        intPointer(data.HistorySize),
+			Krbpwdmindiffchars:         intPointer(data.MinClasses),
+			Krbpwdminlength:            intPointer(data.MinLength),
+			Krbpwdmaxfailure:           intPointer(data.MaxFailures),
+			Krbpwdfailurecountinterval: intPointer(data.FailureInterval),
+			Krbpwdlockoutduration:      intPointer(data.LockoutTime),
+			Passwordgracelimit:         intPointer(data.GraceLimit),
+			Ipapwdmaxrepeat:            intPointer(data.MaxRepeat),
+			Ipapwdmaxsequence:          intPointer(data.MaxSequence),
+		}
+		if !data.DictionaryCheck.IsUnknown() {
+			optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+		}
+		if !data.UserCheck.IsUnknown() {
+			optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+		}
+		_, err := r.client.PwpolicyAdd(&ipa.PwpolicyAddArgs{}, &optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa password policy: %s", err))
+			return
+		}
+	}
+	data.Id = data.Name
+
+	// The attributes not set in the configuration are computed by FreeIPA.
+	r.readPasswordPolicy(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredAttributes(ctx, req.Config, resp.Private)...)
+}
+
+func (r *PasswordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.readPasswordPolicy(ctx, &data, &resp.Diagnostics) {
+		tflog.Debug(ctx, "[DEBUG] Password policy not found")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PasswordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	optArgs := ipa.PwpolicyModOptionalArgs{
+		Cn: &name,
+	}
+
+	if !data.Priority.Equal(state.Priority) {
+		optArgs.Cospriority = intPointer(data.Priority)
+	}
+	r.resetRemovedAttributes(&data, &optArgs)
+	if !data.MaxLifetime.Equal(state.MaxLifetime) {
+		optArgs.Krbmaxpwdlife = intPointer(data.MaxLifetime)
+	}
+	if !data.MinLifetime.Equal(state.MinLifetime) {
+		optArgs.Krbminpwdlife = intPointer(data.MinLifetime)
+	}
+	if !data.HistorySize.Equal(state.HistorySize) {
+		optArgs.Krbpwdhistorylength = intPointer(data.HistorySize)
+	}
+	if !data.MinClasses.Equal(state.MinClasses) {
+		optArgs.Krbpwdmindiffchars = intPointer(data.MinClasses)
+	}
+	if !data.MinLength.Equal(state.MinLength) {
+		optArgs.Krbpwdminlength = intPointer(data.MinLength)
+	}
+	if !data.MaxFailures.Equal(state.MaxFailures) {
+		optArgs.Krbpwdmaxfailure = intPointer(data.MaxFailures)
+	}
+	if !data.FailureInterval.Equal(state.FailureInterval) {
+		optArgs.Krbpwdfailurecountinterval = intPointer(data.FailureInterval)
+	}
+	if !data.LockoutTime.Equal(state.LockoutTime) {
+		optArgs.Krbpwdlockoutduration = intPointer(data.LockoutTime)
+	}
+	if !data.GraceLimit.Equal(state.GraceLimit) {
+		optArgs.Passwordgracelimit = intPointer(data.GraceLimit)
+	}
+	if !data.MaxRepeat.Equal(state.MaxRepeat) {
+		optArgs.Ipapwdmaxrepeat = intPointer(data.MaxRepeat)
+	}
+	if !data.MaxSequence.Equal(state.MaxSequence) {
+		optArgs.Ipapwdmaxsequence = intPointer(data.MaxSequence)
+	}
+	if !data.DictionaryCheck.Equal(state.DictionaryCheck) && !data.DictionaryCheck.IsUnknown() {
+		optArgs.Ipapwddictcheck = data.DictionaryCheck.ValueBoolPointer()
+	}
+	if !data.UserCheck.Equal(state.UserCheck) && !data.UserCheck.IsUnknown() {
+		optArgs.Ipapwdusercheck = data.UserCheck.ValueBoolPointer()
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa password policy %s from plan = %v", name, data))
+	_, err := r.client.PwpolicyMod(&ipa.PwpolicyModArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("EmptyModlist (4202): no modifications to be performed on password policy %s", name))
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa password policy: %s", err))
+			return
+		}
+	}
+	data.Id = data.Name
+
+	// The unset attributes are computed by FreeIPA.
+	r.readPasswordPolicy(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setConfiguredAttributes(ctx, req.Config, resp.Private)...)
+}
+
+func (r *PasswordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() == globalPasswordPolicy {
+		tflog.Debug(ctx, "[DEBUG] Reset freeipa global password policy")
+		name := globalPasswordPolicy
+		optArgs := ipa.PwpolicyModOptionalArgs{
+			Cn:                         &name,
+			Krbmaxpwdlife:              intPointer(passwordPolicyDefaults.MaxLifetime),
+			Krbminpwdlife:              intPointer(passwordPolicyDefaults.MinLifetime),
+			Krbpwdhistorylength:        intPointer(passwordPolicyDefaults.HistorySize),
+			Krbpwdmindiffchars:         intPointer(passwordPolicyDefaults.MinClasses),
+			Krbpwdminlength:            intPointer(passwordPolicyDefaults.MinLength),
+			Krbpwdmaxfailure:           intPointer(passwordPolicyDefaults.MaxFailures),
+			Krbpwdfailurecountinterval: intPointer(passwordPolicyDefaults.FailureInterval),
+			Krbpwdlockoutduration:      intPointer(passwordPolicyDefaults.LockoutTime),
+			Passwordgracelimit:         intPointer(passwordPolicyDefaults.GraceLimit),
+			Ipapwdmaxrepeat:            intPointer(passwordPolicyDefaults.MaxRepeat),
+			Ipapwdmaxsequence:          intPointer(passwordPolicyDefaults.MaxSequence),
+			Ipapwddictcheck:            passwordPolicyDefaults.DictionaryCheck.ValueBoolPointer(),
+			Ipapwdusercheck:            passwordPolicyDefaults.UserCheck.ValueBoolPointer(),
+		}
+		_, err := r.client.PwpolicyMod(&ipa.PwpolicyModArgs{}, &optArgs)
+		if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Global password policy reset failed: %s", err))
+		}
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa password policy %s", data.Id.ValueString()))
+	v := []string{data.Name.ValueString()}
+	optArgs := ipa.PwpolicyDelOptionalArgs{
+		Cn: &v,
+	}
+	_, err := r.client.PwpolicyDel(&ipa.PwpolicyDelArgs{}, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Password policy %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *PasswordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// resetRemovedAttributes resets the attributes removed from the configuration, which are unknown in the plan.
+// They are set to their default value on the global password policy, and unset on the group password policies.
+func (r *PasswordPolicyResource) resetRemovedAttributes(data *PasswordPolicyResourceModel, optArgs *ipa.PwpolicyModOptionalArgs) {
+	global := data.Name.ValueString() == globalPasswordPolicy
+	int64Attributes := []struct {
+		name         string
+		value        *types.Int64
+		defaultValue types.Int64
+	}{
+		{"krbmaxpwdlife", &data.MaxLifetime, passwordPolicyDefaults.MaxLifetime},
+		{"krbminpwdlife", &data.MinLifetime, passwordPolicyDefaults.MinLifetime},
+		{"krbpwdhistorylength", &data.HistorySize, passwordPolicyDefaults.HistorySize},
+		{"krbpwdmindiffchars", &data.MinClasses, passwordPolicyDefaults.MinClasses},
+		{"krbpwdminlength", &data.MinLength, passwordPolicyDefaults.MinLength},
+		{"krbpwdmaxfailure", &data.MaxFailures, passwordPolicyDefaults.MaxFailures},
+		{"krbpwdfailurecountinterval", &data.FailureInterval, passwordPolicyDefaults.FailureInterval},
+		{"krbpwdlockoutduration", &data.LockoutTime, passwordPolicyDefaults.LockoutTime},
+		{"passwordgracelimit", &data.GraceLimit, passwordPolicyDefaults.GraceLimit},
+		{"ipapwdmaxrepeat", &data.MaxRepeat, passwordPolicyDefaults.MaxRepeat},
+		{"ipapwdmaxsequence", &data.MaxSequence, passwordPolicyDefaults.MaxSequence},
+	}
+	for _, attribute := range int64Attributes {
+		if !attribute.value.IsUnknown() {
+			continue
+		}
+		if global {
+			*attribute.value = attribute.defaultValue
+		} else {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, attribute.name+"=")
+		}
+	}
+	boolAttributes := []struct {
+		name         string
+		value        *types.Bool
+		defaultValue types.Bool
+	}{
+		{"ipapwddictcheck", &data.DictionaryCheck, passwordPolicyDefaults.DictionaryCheck},
+		{"ipapwdusercheck", &data.UserCheck, passwordPolicyDefaults.UserCheck},
+	}
+	for _, attribute := range boolAttributes {
+		if !attribute.value.IsUnknown() {
+			continue
+		}
+		if global {
+			*attribute.value = attribute.defaultValue
+		} else {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, attribute.name+"=")
+		}
+	}
+}
+
+// readPasswordPolicy updates the model with the password policy read from FreeIPA.
+// It returns false when the password policy does not exist.
+func (r *PasswordPolicyResource) readPasswordPolicy(ctx context.Context, data *PasswordPolicyResourceModel, diags *diag.Diagnostics) bool {
+	all := true
+	name := data.Name.ValueString()
+	optArgs := ipa.PwpolicyShowOptionalArgs{
+		Cn:  &name,
+		All: &all,
+	}
+
+	res, err := r.client.PwpolicyShow(&ipa.PwpolicyShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			return false
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Error reading freeipa password policy: %s", err))
+		return true
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa password policy %s", res.Result.String()))
+
+	if name != globalPasswordPolicy {
+		data.Priority = int64Value(res.Result.Cospriority)
+	}
+	data.MaxLifetime = int64Value(res.Result.Krbmaxpwdlife)
+	data.MinLifetime = int64Value(res.Result.Krbminpwdlife)
+	data.HistorySize = int64Value(res.Result.Krbpwdhistorylength)
+	data.MinClasses = int64Value(res.Result.Krbpwdmindiffchars)
+	data.MinLength = int64Value(res.Result.Krbpwdminlength)
+	data.MaxFailures = int64Value(res.Result.Krbpwdmaxfailure)
+	data.FailureInterval = int64Value(res.Result.Krbpwdfailurecountinterval)
+	data.LockoutTime = int64Value(res.Result.Krbpwdlockoutduration)
+	data.GraceLimit = int64Value(res.Result.Passwordgracelimit)
+	data.MaxRepeat = int64Value(res.Result.Ipapwdmaxrepeat)
+	data.MaxSequence = int64Value(res.Result.Ipapwdmaxsequence)
+	data.DictionaryCheck = types.BoolPointerValue(res.Result.Ipapwddictcheck)
+	data.UserCheck = types.BoolPointerValue(res.Result.Ipapwdusercheck)
+	data.Id = data.Name
+	return true
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPasswordPolicy_full(t *testing.T) {
+	testGroup := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-group-0\"",
+		"description": "\"User group test 0\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_group.group-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testPwPolicy := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_group.group-0.name",
+		"priority":   "10",
+		"min_length": "12",
+	}
+	testPwPolicyModified := map[string]string{
+		"index":            "0",
+		"name":             "freeipa_group.group-0.name",
+		"priority":         "20",
+		"max_lifetime":     "180",
+		"min_lifetime":     "2",
+		"history_size":     "6",
+		"min_classes":      "3",
+		"min_length":       "16",
+		"max_failures":     "5",
+		"failure_interval": "120",
+		"lockout_time":     "900",
+		"grace_limit":      "2",
+		"max_repeat":       "3",
+		"max_sequence":     "4",
+		"dictionary_check": "true",
+		"user_check":       "true",
+	}
+	testPwPolicyNoPriority := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-group-0\"",
+		"min_length": "12",
+	}
+	testPwPolicyGlobalPriority := map[string]string{
+		"index":    "0",
+		"name":     "\"global_policy\"",
+		"priority": "10",
+	}
+	testPwPolicyDS := map[string]string{
+		"index": "0",
+		"user":  "freeipa_user_group_membership.membership-0.user",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPwPolicyNoPriority),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The priority is required for the password policy of the group testacc-group-0"),
+			},
+			{
+				Config:      testAccFreeIPAProvider() + testAccFreeIPAPasswordPolicy_resource(testPwPolicyGlobalPriority),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The priority cannot be set on the global password policy"),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPwPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "name", "testacc-group-0"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "priority", "10"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "min_length", "12"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPwPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserGroupMembership_resource(testMembership) + testAccFreeIPAPasswordPolicy_resource(testPwPolicyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "priority", "20"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "max_lifetime", "180"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "min_lifetime", "2"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "history_size", "6"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "min_classes", "3"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "min_length", "16"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "max_failures", "5"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "failure_interval", "120"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "lockout_time", "900"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "grace_limit", "2"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "max_repeat", "3"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "max_sequence", "4"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "dictionary_check", "true"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "user_check", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserGroupMembership_resource(testMembership) + testAccFreeIPAPasswordPolicy_resource(testPwPolicyModified) + testAccFreeIPAPasswordPolicy_datasource(testPwPolicyDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.pwpolicy-0", "name", "testacc-group-0"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.pwpolicy-0", "priority", "20"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.pwpolicy-0", "min_length", "16"),
+					resource.TestCheckResourceAttr("data.freeipa_password_policy.pwpolicy-0", "max_failures", "5"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserGroupMembership_resource(testMembership) + testAccFreeIPAPasswordPolicy_resource(testPwPolicyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// The attributes removed from the configuration are unset.
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPwPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "priority", "10"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "min_length", "12"),
+					resource.TestCheckResourceAttr("freeipa_password_policy.pwpolicy-0", "user_check", "false"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAPasswordPolicy_resource(testPwPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
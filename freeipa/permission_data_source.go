@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionDataSource{}
+var _ datasource.DataSourceWithConfigure = &PermissionDataSource{}
+
+func NewPermissionDataSource() datasource.DataSource {
+	return &PermissionDataSource{}
+}
+
+// PermissionDataSource defines the data source implementation.
+type PermissionDataSource struct {
+	client *ipa.Client
+}
+
+// PermissionDataSourceModel describes the data source data model.
+type PermissionDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Rights          types.List   `tfsdk:"rights"`
+	Attributes      types.List   `tfsdk:"attributes"`
+	BindType        types.String `tfsdk:"bind_type"`
+	Type            types.String `tfsdk:"type"`
+	Subtree         types.String `tfsdk:"subtree"`
+	TargetFilter    types.List   `tfsdk:"target_filter"`
+	Target          types.String `tfsdk:"target"`
+	TargetGroup     types.String `tfsdk:"target_group"`
+	MemberOf        types.List   `tfsdk:"memberof"`
+	MemberPrivilege types.List   `tfsdk:"member_privilege"`
+}
+
+func (r *PermissionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *PermissionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA permission data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission",
+				Required:            true,
+			},
+			"rights": schema.ListAttribute{
+				MarkdownDescription: "Rights granted by the permission",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"attributes": schema.ListAttribute{
+				MarkdownDescription: "Attributes the rights apply to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"bind_type": schema.StringAttribute{
+				MarkdownDescription: "Bind rule type",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the target entries",
+				Computed:            true,
+			},
+			"subtree": schema.StringAttribute{
+				MarkdownDescription: "DN of the subtree the permission applies to",
+				Computed:            true,
+			},
+			"target_filter": schema.ListAttribute{
+				MarkdownDescription: "LDAP filters the target entries must match",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "DN of the target entries",
+				Computed:            true,
+			},
+			"target_group": schema.StringAttribute{
+				MarkdownDescription: "User group the permission applies to",
+				Computed:            true,
+			},
+			"memberof": schema.ListAttribute{
+				MarkdownDescription: "Target entries must be members of these groups",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_privilege": schema.ListAttribute{
+				MarkdownDescription: "List of privileges the permission is granted to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PermissionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PermissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.PermissionShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.PermissionShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.PermissionShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa permission %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa permission %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Ipapermright != nil {
+		data.Rights, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipapermright)
+	}
+	if res.Result.Attrs != nil {
+		data.Attributes, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Attrs)
+	}
+	if res.Result.Ipapermbindruletype != nil {
+		data.BindType = types.StringValue(*res.Result.Ipapermbindruletype)
+	}
+	if res.Result.Type != nil {
+		data.Type = types.StringValue(*res.Result.Type)
+	}
+	if res.Result.Ipapermlocation != nil {
+		data.Subtree = types.StringValue(*res.Result.Ipapermlocation)
+	}
+	if res.Result.Extratargetfilter != nil {
+		data.TargetFilter, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Extratargetfilter)
+	}
+	if res.Result.Ipapermtarget != nil {
+		data.Target = types.StringValue(*res.Result.Ipapermtarget)
+	}
+	if res.Result.Targetgroup != nil {
+		data.TargetGroup = types.StringValue(*res.Result.Targetgroup)
+	}
+	if res.Result.Memberof != nil {
+		data.MemberOf, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Memberof)
+	}
+	if res.Result.MemberPrivilege != nil {
+		data.MemberPrivilege, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberPrivilege)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
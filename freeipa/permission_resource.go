@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PermissionResource{}
+var _ resource.ResourceWithImportState = &PermissionResource{}
+
+func NewPermissionResource() resource.Resource {
+	return &PermissionResource{}
+}
+
+// PermissionResource defines the resource implementation.
+type PermissionResource struct {
+	client *ipa.Client
+}
+
+// PermissionResourceModel describes the resource data model.
+type PermissionResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Rights       types.Set    `tfsdk:"rights"`
+	Attributes   types.Set    `tfsdk:"attributes"`
+	BindType     types.String `tfsdk:"bind_type"`
+	Type         types.String `tfsdk:"type"`
+	Subtree      types.String `tfsdk:"subtree"`
+	TargetFilter types.Set    `tfsdk:"target_filter"`
+	Target       types.String `tfsdk:"target"`
+	TargetGroup  types.String `tfsdk:"target_group"`
+	MemberOf     types.Set    `tfsdk:"memberof"`
+}
+
+func (r *PermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *PermissionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *PermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA permission resource.\nA permission grants rights on a set of LDAP entries and attributes. Permissions are assigned to privileges.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rights": schema.SetAttribute{
+				MarkdownDescription: "Rights to grant (`read`, `search`, `compare`, `write`, `add`, `delete`, `all`)",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("read", "search", "compare", "write", "add", "delete", "all")),
+				},
+			},
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes the rights apply to",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"bind_type": schema.StringAttribute{
+				MarkdownDescription: "Bind rule type (`permission`, `all`, `anonymous`, `self`). Defaults to `permission` in FreeIPA.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("permission", "all", "anonymous", "self"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the target entries (e.g. `user`, `group`, `host`, `hostgroup`, `service`). Sets the subtree and the object class filter.",
+				Optional:            true,
+			},
+			"subtree": schema.StringAttribute{
+				MarkdownDescription: "DN of the subtree the permission applies to",
+				Optional:            true,
+			},
+			"target_filter": schema.SetAttribute{
+				MarkdownDescription: "LDAP filters the target entries must match",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "DN of the target entries, wildcards are allowed",
+				Optional:            true,
+			},
+			"target_group": schema.StringAttribute{
+				MarkdownDescription: "User group to apply the permission to",
+				Optional:            true,
+			},
+			"memberof": schema.SetAttribute{
+				MarkdownDescription: "Target entries must be members of these groups",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PermissionAddOptionalArgs{}
+
+	args := ipa.PermissionAddArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	rights := setStringValues(data.Rights)
+	optArgs.Ipapermright = &rights
+	if len(data.Attributes.Elements()) > 0 {
+		v := setStringValues(data.Attributes)
+		optArgs.Attrs = &v
+	}
+	if !data.BindType.IsNull() {
+		optArgs.Ipapermbindruletype = data.BindType.ValueStringPointer()
+	}
+	if !data.Type.IsNull() {
+		optArgs.Type = data.Type.ValueStringPointer()
+	}
+	if !data.Subtree.IsNull() {
+		optArgs.Ipapermlocation = data.Subtree.ValueStringPointer()
+	}
+	if len(data.TargetFilter.Elements()) > 0 {
+		v := setStringValues(data.TargetFilter)
+		optArgs.Extratargetfilter = &v
+	}
+	if !data.Target.IsNull() {
+		optArgs.Ipapermtarget = data.Target.ValueStringPointer()
+	}
+	if !data.TargetGroup.IsNull() {
+		optArgs.Targetgroup = data.TargetGroup.ValueStringPointer()
+	}
+	if len(data.MemberOf.Elements()) > 0 {
+		v := setStringValues(data.MemberOf)
+		optArgs.Memberof = &v
+	}
+
+	_, err := r.client.PermissionAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa permission: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.PermissionShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.PermissionShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.PermissionShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Permission not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa permission: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa permission %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa permission %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Ipapermright != nil {
+		data.Rights, _ = types.SetValueFrom(ctx, types.StringType, keepStringSetCase(data.Rights, res.Result.Ipapermright))
+	}
+	if res.Result.Attrs != nil && !data.Attributes.IsNull() {
+		data.Attributes, _ = types.SetValueFrom(ctx, types.StringType, keepStringSetCase(data.Attributes, res.Result.Attrs))
+	}
+	if res.Result.Ipapermbindruletype != nil && !data.BindType.IsNull() {
+		data.BindType = types.StringValue(*res.Result.Ipapermbindruletype)
+	}
+	if res.Result.Type != nil && !data.Type.IsNull() {
+		data.Type = types.StringValue(*res.Result.Type)
+	}
+	if res.Result.Ipapermlocation != nil && !data.Subtree.IsNull() {
+		data.Subtree = types.StringValue(*res.Result.Ipapermlocation)
+	}
+	if res.Result.Extratargetfilter != nil && !data.TargetFilter.IsNull() {
+		data.TargetFilter, _ = types.SetValueFrom(ctx, types.StringType, keepStringSetCase(data.TargetFilter, res.Result.Extratargetfilter))
+	}
+	if res.Result.Ipapermtarget != nil && !data.Target.IsNull() {
+		data.Target = types.StringValue(*res.Result.Ipapermtarget)
+	}
+	if res.Result.Targetgroup != nil && !data.TargetGroup.IsNull() {
+		data.TargetGroup = types.StringValue(*res.Result.Targetgroup)
+	}
+	if res.Result.Memberof != nil && !data.MemberOf.IsNull() {
+		data.MemberOf, _ = types.SetValueFrom(ctx, types.StringType, keepStringSetCase(data.MemberOf, res.Result.Memberof))
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PermissionModOptionalArgs{}
+
+	args := ipa.PermissionModArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+	empty := ""
+
+	if !data.Rights.Equal(state.Rights) {
+		v := setStringValues(data.Rights)
+		optArgs.Ipapermright = &v
+		hasChange = true
+	}
+	if !data.Attributes.Equal(state.Attributes) {
+		v := setStringValues(data.Attributes)
+		optArgs.Attrs = &v
+		hasChange = true
+	}
+	if !data.BindType.Equal(state.BindType) {
+		// FreeIPA falls back to the permission bind type when the attribute is removed from the configuration.
+		v := "permission"
+		optArgs.Ipapermbindruletype = &v
+		if !data.BindType.IsNull() {
+			optArgs.Ipapermbindruletype = data.BindType.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Type.Equal(state.Type) {
+		optArgs.Type = &empty
+		if !data.Type.IsNull() {
+			optArgs.Type = data.Type.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Subtree.Equal(state.Subtree) {
+		optArgs.Ipapermlocation = &empty
+		if !data.Subtree.IsNull() {
+			optArgs.Ipapermlocation = data.Subtree.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.TargetFilter.Equal(state.TargetFilter) {
+		v := setStringValues(data.TargetFilter)
+		optArgs.Extratargetfilter = &v
+		hasChange = true
+	}
+	if !data.Target.Equal(state.Target) {
+		optArgs.Ipapermtarget = &empty
+		if !data.Target.IsNull() {
+			optArgs.Ipapermtarget = data.Target.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.TargetGroup.Equal(state.TargetGroup) {
+		optArgs.Targetgroup = &empty
+		if !data.TargetGroup.IsNull() {
+			optArgs.Targetgroup = data.TargetGroup.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.MemberOf.Equal(state.MemberOf) {
+		v := setStringValues(data.MemberOf)
+		optArgs.Memberof = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.PermissionMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa permission: %s", err))
+				return
+			}
+		}
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa permission %s", data.Name.ValueString()))
+	args := ipa.PermissionDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	optArgs := ipa.PermissionDelOptionalArgs{}
+	_, err := r.client.PermissionDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Permission %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPermission_full(t *testing.T) {
+	testPermission := map[string]string{
+		"index":  "0",
+		"name":   "\"testacc-permission\"",
+		"rights": "[\"read\", \"search\", \"compare\"]",
+		"type":   "\"user\"",
+	}
+	testPermissionModified := map[string]string{
+		"index":         "0",
+		"name":          "\"testacc-permission\"",
+		"rights":        "[\"write\"]",
+		"attributes":    "[\"telephoneNumber\", \"mobile\"]",
+		"bind_type":     "\"permission\"",
+		"type":          "\"user\"",
+		"target_filter": "[\"(ou=testacc)\"]",
+	}
+	testPermissionDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_permission.permission-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermission),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "name", "testacc-permission"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "rights.#", "3"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "type", "user"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermissionModified) + testAccFreeIPAPermission_datasource(testPermissionDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "rights.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.permission-0", "rights.*", "write"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "attributes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.permission-0", "attributes.*", "telephoneNumber"),
+					resource.TestCheckResourceAttr("freeipa_permission.permission-0", "bind_type", "permission"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.permission-0", "target_filter.*", "(ou=testacc)"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "name", "testacc-permission"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "type", "user"),
+					resource.TestCheckResourceAttr("data.freeipa_permission.permission-0", "rights.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPermission_resource(testPermissionModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
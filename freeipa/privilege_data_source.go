@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PrivilegeDataSource{}
+var _ datasource.DataSourceWithConfigure = &PrivilegeDataSource{}
+
+func NewPrivilegeDataSource() datasource.DataSource {
+	return &PrivilegeDataSource{}
+}
+
+// PrivilegeDataSource defines the data source implementation.
+type PrivilegeDataSource struct {
+	client *ipa.Client
+}
+
+// PrivilegeDataSourceModel describes the data source data model.
+type PrivilegeDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	MemberOfPermission types.List   `tfsdk:"memberof_permission"`
+	MemberRole         types.List   `tfsdk:"member_role"`
+}
+
+func (r *PrivilegeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege"
+}
+
+func (r *PrivilegeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA privilege data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the privilege",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the privilege",
+				Computed:            true,
+			},
+			"memberof_permission": schema.ListAttribute{
+				MarkdownDescription: "List of permissions granted by the privilege",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_role": schema.ListAttribute{
+				MarkdownDescription: "List of roles the privilege is granted to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PrivilegeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivilegeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrivilegeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.PrivilegeShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.PrivilegeShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.PrivilegeShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa privilege %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa privilege %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.MemberofPermission != nil {
+		data.MemberOfPermission, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberofPermission)
+	}
+	if res.Result.MemberRole != nil {
+		data.MemberRole, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberRole)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
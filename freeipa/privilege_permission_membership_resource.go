@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrivilegePermissionMembershipResource{}
+var _ resource.ResourceWithImportState = &PrivilegePermissionMembershipResource{}
+
+func NewPrivilegePermissionMembershipResource() resource.Resource {
+	return &PrivilegePermissionMembershipResource{}
+}
+
+// PrivilegePermissionMembershipResource defines the resource implementation.
+type PrivilegePermissionMembershipResource struct {
+	client *ipa.Client
+}
+
+// PrivilegePermissionMembershipResourceModel describes the resource data model.
+type PrivilegePermissionMembershipResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permission  types.String `tfsdk:"permission"`
+	Permissions types.List   `tfsdk:"permissions"`
+	Identifier  types.String `tfsdk:"identifier"`
+}
+
+func (r *PrivilegePermissionMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege_permission_membership"
+}
+
+func (r *PrivilegePermissionMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("permission"),
+			path.MatchRoot("permissions"),
+		),
+	}
+}
+
+func (r *PrivilegePermissionMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA privilege permission membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the privilege",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				MarkdownDescription: "Permission to add to the privilege",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: "List of permissions to add to the privilege",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple privilege permission membership resources on the same privilege. Mandatory for using permissions configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivilegePermissionMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivilegePermissionMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivilegePermissionMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.PrivilegeAddPermissionOptionalArgs{}
+
+	args := ipa.PrivilegeAddPermissionArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Permission.IsNull() {
+		v := []string{data.Permission.ValueString()}
+		optArgs.Permission = &v
+		id = fmt.Sprintf("%s/p/%s", encodeSlash(data.Name.ValueString()), data.Permission.ValueString())
+	}
+	if !data.Permissions.IsNull() {
+		v := listStringValues(data.Permissions)
+		optArgs.Permission = &v
+		id = fmt.Sprintf("%s/mp/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.PrivilegeAddPermission(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa privilege permission membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa privilege permission membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivilegePermissionMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PrivilegePermissionMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privilegeId, typeId, memberId, err := parsePrivilegePermissionMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_privilege_permission_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.PrivilegeShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.PrivilegeShowArgs{
+		Cn: privilegeId,
+	}
+
+	res, err := r.client.PrivilegeShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Privilege not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa privilege: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "p":
+		if res.Result.MemberofPermission == nil || !isStringListContainsCaseInsensistive(res.Result.MemberofPermission, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] privilege permission membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mp":
+		if !data.Permissions.IsNull() {
+			var diag diag.Diagnostics
+			data.Permissions, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Permissions, res.Result.MemberofPermission))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PrivilegePermissionMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PrivilegePermissionMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	addedPermissions, deletedPermissions := diffStringLists(state.Permissions, data.Permissions)
+
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if len(addedPermissions) > 0 {
+		args := ipa.PrivilegeAddPermissionArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.PrivilegeAddPermission(&args, &ipa.PrivilegeAddPermissionOptionalArgs{Permission: &addedPermissions})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa privilege permission membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa privilege permission membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa privilege permission membership: %v", _v.Failed))
+		}
+	}
+	if len(deletedPermissions) > 0 {
+		args := ipa.PrivilegeRemovePermissionArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.PrivilegeRemovePermission(&args, &ipa.PrivilegeRemovePermissionOptionalArgs{Permission: &deletedPermissions})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa privilege permission membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa privilege permission membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa privilege permission membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivilegePermissionMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PrivilegePermissionMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	privilegeId, typeId, memberId, err := parsePrivilegePermissionMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_privilege_permission_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.PrivilegeRemovePermissionOptionalArgs{}
+
+	args := ipa.PrivilegeRemovePermissionArgs{
+		Cn: privilegeId,
+	}
+
+	switch typeId {
+	case "p":
+		v := []string{memberId}
+		optArgs.Permission = &v
+	case "mp":
+		v := listStringValues(data.Permissions)
+		optArgs.Permission = &v
+	}
+
+	_, err = r.client.PrivilegeRemovePermission(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa privilege permission membership: %s", err))
+		return
+	}
+}
+
+func (r *PrivilegePermissionMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	privilegeId, typeId, memberId, err := parsePrivilegePermissionMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.PrivilegeShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.PrivilegeShowArgs{
+		Cn: privilegeId,
+	}
+
+	res, err := r.client.PrivilegeShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Privilege not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa privilege: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), privilegeId)...)
+
+	switch typeId {
+	case "p":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), memberId)...)
+	case "mp":
+		// The imported membership manages all the permissions currently member of the privilege.
+		var permissions []string
+		if res.Result.MemberofPermission != nil {
+			permissions = *res.Result.MemberofPermission
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permissions"), permissions)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parsePrivilegePermissionMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine privilege permission membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	permission := idParts[2]
+
+	return name, _type, permission, nil
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPrivilegePermissionMembership_simple(t *testing.T) {
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testPermission := map[string]string{
+		"index":  "0",
+		"name":   "\"testacc-permission-0\"",
+		"rights": "[\"read\"]",
+		"type":   "\"user\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_privilege.privilege-0.name",
+		"permission": "freeipa_permission.permission-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPAPermission_resource(testPermission) + testAccFreeIPAPrivilegePermissionMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "name", "testacc-privilege"),
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permission", "testacc-permission-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPAPermission_resource(testPermission) + testAccFreeIPAPrivilegePermissionMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPAPrivilegePermissionMembership_multiple(t *testing.T) {
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testPermission0 := map[string]string{
+		"index":  "0",
+		"name":   "\"testacc-permission-0\"",
+		"rights": "[\"read\"]",
+		"type":   "\"user\"",
+	}
+	testPermission1 := map[string]string{
+		"index":  "1",
+		"name":   "\"testacc-permission-1\"",
+		"rights": "[\"read\"]",
+		"type":   "\"group\"",
+	}
+	testMembership := map[string]string{
+		"index":       "0",
+		"name":        "freeipa_privilege.privilege-0.name",
+		"permissions": "[freeipa_permission.permission-0.name, freeipa_permission.permission-1.name]",
+		"identifier":  "\"permissions-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":       "0",
+		"name":        "freeipa_privilege.privilege-0.name",
+		"permissions": "[freeipa_permission.permission-1.name]",
+		"identifier":  "\"permissions-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPAPermission_resource(testPermission0) + testAccFreeIPAPermission_resource(testPermission1) + testAccFreeIPAPrivilegePermissionMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "name", "testacc-privilege"),
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPAPermission_resource(testPermission0) + testAccFreeIPAPermission_resource(testPermission1) + testAccFreeIPAPrivilegePermissionMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_privilege_permission_membership.privilege-permission-membership-0", "permissions.0", "testacc-permission-1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPAPermission_resource(testPermission0) + testAccFreeIPAPermission_resource(testPermission1) + testAccFreeIPAPrivilegePermissionMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
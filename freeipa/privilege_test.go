@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAPrivilege_full(t *testing.T) {
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testPrivilegeModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-privilege\"",
+		"description": "\"A privilege for acceptance tests\"",
+	}
+	testPrivilegeDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_privilege.privilege-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilege),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege.privilege-0", "name", "testacc-privilege"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilegeModified) + testAccFreeIPAPrivilege_datasource(testPrivilegeDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege.privilege-0", "description", "A privilege for acceptance tests"),
+					resource.TestCheckResourceAttr("data.freeipa_privilege.privilege-0", "name", "testacc-privilege"),
+					resource.TestCheckResourceAttr("data.freeipa_privilege.privilege-0", "description", "A privilege for acceptance tests"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAPrivilege_resource(testPrivilegeModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -21,16 +21,24 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
 )
 
 // Ensure freeipaProvider satisfies various provider interfaces.
@@ -56,11 +64,35 @@ type freeipaProvider struct {
 
 // freeipaProviderModel describes the provider data model.
 type freeipaProviderModel struct {
-	Host               types.String `tfsdk:"host"`
-	Username           types.String `tfsdk:"username"`
-	Password           types.String `tfsdk:"password"`
-	InsecureSkipVerify types.Bool   `tfsdk:"insecure"`
-	CaCertificate      types.String `tfsdk:"ca_certificate"`
+	Host               types.String       `tfsdk:"host"`
+	Hosts              types.List         `tfsdk:"hosts"`
+	DiscoveryDomain    types.String       `tfsdk:"discovery_domain"`
+	Username           types.String       `tfsdk:"username"`
+	Password           types.String       `tfsdk:"password"`
+	InsecureSkipVerify types.Bool         `tfsdk:"insecure"`
+	CaCertificate      types.String       `tfsdk:"ca_certificate"`
+	CaCertificatePem   types.String       `tfsdk:"ca_certificate_pem"`
+	ProxyUrl           types.String       `tfsdk:"proxy_url"`
+	ExtraHeaders       types.Map          `tfsdk:"extra_headers"`
+	TraceRequests      types.Bool         `tfsdk:"trace_requests"`
+	Principal          types.String       `tfsdk:"principal"`
+	Keytab             types.String       `tfsdk:"keytab"`
+	UseCCache          types.Bool         `tfsdk:"use_ccache"`
+	Krb5Config         types.String       `tfsdk:"krb5_config"`
+	ClientCertificate  types.String       `tfsdk:"client_certificate"`
+	ClientKey          types.String       `tfsdk:"client_key"`
+	Retry              *freeipaRetryModel `tfsdk:"retry"`
+	MaxConcurrent      types.Int64        `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond  types.Float64      `tfsdk:"requests_per_second"`
+}
+
+// freeipaRetryModel describes the retry policy applied to the FreeIPA API calls.
+type freeipaRetryModel struct {
+	MaxAttempts         types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff          types.String `tfsdk:"min_backoff"`
+	MaxBackoff          types.String `tfsdk:"max_backoff"`
+	Timeout             types.String `tfsdk:"timeout"`
+	RetryableErrorCodes types.List   `tfsdk:"retryable_error_codes"`
 }
 
 func (p *freeipaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -75,14 +107,23 @@ func (p *freeipaProvider) Schema(ctx context.Context, req provider.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				MarkdownDescription: "The FreeIPA host. Can be set through the environment variable `FREEIPA_HOST`.",
-				Required:            true,
+				Optional:            true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "FreeIPA replicas to fail over to, in order, when `host` is unreachable or unavailable (HTTP 503). A call that reached a server is never sent again to a replica. Can be set through the environment variable `FREEIPA_HOSTS` (comma separated list).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"discovery_domain": schema.StringAttribute{
+				MarkdownDescription: "IPA domain whose servers are discovered from the `_ldap._tcp` (or `_kerberos._tcp`) DNS SRV records. The discovered servers are used after `host` and `hosts`. Can be set through the environment variable `FREEIPA_DISCOVERY_DOMAIN`.",
+				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username to use for connection. Can be set through the environment variable `FREEIPA_USERNAME`.",
+				MarkdownDescription: "Username to use for connection. Required for password authentication. Can be set through the environment variable `FREEIPA_USERNAME`.",
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password to use for connection. Can be set through the environment variable `FREEIPA_PASSWORD`.",
+				MarkdownDescription: "Password to use for connection. Required for password authentication. Can be set through the environment variable `FREEIPA_PASSWORD`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
@@ -94,6 +135,93 @@ func (p *freeipaProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				MarkdownDescription: "Path to the server's SSL CA certificate. Can be set through the environment variable `FREEIPA_CA_CERT`.",
 				Optional:            true,
 			},
+			"ca_certificate_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM content of the server's SSL CA certificate(s), e.g. read from Vault. Used along with `ca_certificate` when both are set. Can be set through the environment variable `FREEIPA_CA_CERT_PEM`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach the FreeIPA servers (e.g. `http://proxy.example.test:3128`). The `HTTPS_PROXY` and `NO_PROXY` environment variables are used by default. Can be set through the environment variable `FREEIPA_PROXY_URL`.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, e.g. the authentication header required by a reverse proxy in front of FreeIPA.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"trace_requests": schema.BoolAttribute{
+				MarkdownDescription: "Log every call made to the FreeIPA API (method, arguments, duration, HTTP status and IPA error) at debug level. Passwords, keys and other secrets are masked. Can be set through the environment variable `FREEIPA_TRACE_REQUESTS`.",
+				Optional:            true,
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "Kerberos principal (e.g. `host/ci.example.test@EXAMPLE.TEST`) used with `keytab`. The default realm of the kerberos configuration is used when the principal has no realm. Can be set through the environment variable `FREEIPA_PRINCIPAL`.",
+				Optional:            true,
+			},
+			"keytab": schema.StringAttribute{
+				MarkdownDescription: "Path to a keytab holding the key of `principal`. When set, the provider authenticates with Kerberos instead of a password. Can be set through the environment variable `FREEIPA_KEYTAB`.",
+				Optional:            true,
+			},
+			"use_ccache": schema.BoolAttribute{
+				MarkdownDescription: "Authenticate with the Kerberos ticket of the credential cache (e.g. obtained with `kinit`). The file cache pointed by `KRB5CCNAME` is used, `/tmp/krb5cc_<uid>` otherwise; `KEYRING`, `KCM` and `DIR` caches are not supported. Can be set through the environment variable `FREEIPA_USE_CCACHE`.",
+				Optional:            true,
+			},
+			"krb5_config": schema.StringAttribute{
+				MarkdownDescription: "Path to the Kerberos configuration file used with `keytab` or `use_ccache`. Defaults to the environment variable `KRB5_CONFIG`, `/etc/krb5.conf` otherwise.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "Client certificate presented to the FreeIPA server, as a path to a PEM file or as PEM content. Without password, the provider logs in with this certificate. Can be set through the environment variable `FREEIPA_CLIENT_CERT`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "Private key of `client_certificate`, as a path to a PEM file or as PEM content. Can be set through the environment variable `FREEIPA_CLIENT_KEY`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Retry policy applied to every call made to the FreeIPA API. Only the calls that did not reach FreeIPA are retried: connection errors raised before the request was sent and HTTP 429 and 503 responses, as well as the IPA errors listed in `retryable_error_codes`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of attempts for each call, including the first one (default to `1`, no retry)",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: "Delay before the first retry, doubled after each attempt (e.g. `500ms`, default to `1s`)",
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "Maximum delay between two attempts (e.g. `1m`, default to `30s`)",
+						Optional:            true,
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: "Timeout of each attempt (e.g. `2m`). No timeout by default.",
+						Optional:            true,
+					},
+					"retryable_error_codes": schema.ListAttribute{
+						MarkdownDescription: "IPA error codes to retry, e.g. `4001` (NotFound) to wait for the replication of an entry created on another replica.",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent concurrently to the FreeIPA servers, whatever the Terraform parallelism. Unlimited by default. Can be set through the environment variable `FREEIPA_MAX_CONCURRENT_REQUESTS`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of the requests sent to the FreeIPA servers. Unlimited by default. Can be set through the environment variable `FREEIPA_REQUESTS_PER_SECOND`.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
 		},
 	}
 }
@@ -116,6 +244,19 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		config.Host = types.StringValue(os.Getenv("FREEIPA_HOST"))
 	}
 
+	if config.Hosts.IsNull() && os.Getenv("FREEIPA_HOSTS") != "" {
+		hosts := strings.Split(os.Getenv("FREEIPA_HOSTS"), ",")
+		for i := range hosts {
+			hosts[i] = strings.TrimSpace(hosts[i])
+		}
+		config.Hosts, diags = types.ListValueFrom(ctx, types.StringType, hosts)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if config.DiscoveryDomain.IsNull() {
+		config.DiscoveryDomain = types.StringValue(os.Getenv("FREEIPA_DISCOVERY_DOMAIN"))
+	}
+
 	if config.Username.IsNull() {
 		config.Username = types.StringValue(os.Getenv("FREEIPA_USERNAME"))
 	}
@@ -132,39 +273,186 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 		config.CaCertificate = types.StringValue(os.Getenv("FREEIPA_CA_CERT"))
 	}
 
+	if config.CaCertificatePem.IsNull() {
+		config.CaCertificatePem = types.StringValue(os.Getenv("FREEIPA_CA_CERT_PEM"))
+	}
+
+	if config.TraceRequests.IsNull() {
+		config.TraceRequests = types.BoolValue(getEnvAsBool("FREEIPA_TRACE_REQUESTS", false))
+	}
+
+	if config.ProxyUrl.IsNull() {
+		config.ProxyUrl = types.StringValue(os.Getenv("FREEIPA_PROXY_URL"))
+	}
+
+	if config.Principal.IsNull() {
+		config.Principal = types.StringValue(os.Getenv("FREEIPA_PRINCIPAL"))
+	}
+
+	if config.Keytab.IsNull() {
+		config.Keytab = types.StringValue(os.Getenv("FREEIPA_KEYTAB"))
+	}
+
+	if config.UseCCache.IsNull() {
+		config.UseCCache = types.BoolValue(getEnvAsBool("FREEIPA_USE_CCACHE", false))
+	}
+
+	if config.Krb5Config.IsNull() {
+		config.Krb5Config = types.StringValue(defaultKrb5ConfPath())
+	}
+
+	if config.ClientCertificate.IsNull() {
+		config.ClientCertificate = types.StringValue(os.Getenv("FREEIPA_CLIENT_CERT"))
+	}
+
+	if config.ClientKey.IsNull() {
+		config.ClientKey = types.StringValue(os.Getenv("FREEIPA_CLIENT_KEY"))
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if config.Host.ValueString() == "" {
+	if config.Host.ValueString() == "" && len(config.Hosts.Elements()) == 0 && config.DiscoveryDomain.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing FreeIPA Host",
 			"The provider cannot create the FreeIPA API client as there is a missing or empty value for the FreeIPA host. "+
-				"Set the host value in the configuration or use the FREEIPA_HOST environment variable. "+
+				"Set the host, hosts or discovery_domain value in the configuration or use the FREEIPA_HOST, FREEIPA_HOSTS or FREEIPA_DISCOVERY_DOMAIN environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if config.Username.ValueString() == "" {
+	if config.ClientCertificate.ValueString() != "" && config.ClientKey.ValueString() == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing FreeIPA Username",
-			"The provider cannot create the FreeIPA API client as there is a missing or empty value for the FreeIPA username. "+
-				"Set the username value in the configuration or use the FREEIPA_USERNAME environment variable. "+
+			path.Root("client_key"),
+			"Missing FreeIPA Client Key",
+			"The provider cannot use the client certificate as there is a missing or empty value for its private key. "+
+				"Set the client_key value in the configuration or use the FREEIPA_CLIENT_KEY environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
-
-	if config.Password.ValueString() == "" {
+	if config.ClientCertificate.ValueString() == "" && config.ClientKey.ValueString() != "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing FreeIPA Password",
-			"The provider cannot create the FreeIPA API client as there is a missing or empty value for the FreeIPA password. "+
-				"Set the password value in the configuration or use the FREEIPA_PASSWORD environment variable. "+
+			path.Root("client_certificate"),
+			"Missing FreeIPA Client Certificate",
+			"The provider cannot use the client key as there is a missing or empty value for the client certificate. "+
+				"Set the client_certificate value in the configuration or use the FREEIPA_CLIENT_CERT environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	// The authentication mode is chosen from the provided credentials: keytab first,
+	// then the kerberos credential cache, then the client certificate when no password is set, then username and password.
+	switch {
+	case config.Keytab.ValueString() != "":
+		if config.Principal.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("principal"),
+				"Missing FreeIPA Kerberos Principal",
+				"The provider cannot authenticate with the keytab as there is a missing or empty value for the kerberos principal. "+
+					"Set the principal value in the configuration or use the FREEIPA_PRINCIPAL environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+	case config.UseCCache.ValueBool():
+		ccachePath, err := defaultCCachePath()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_ccache"),
+				"Unsupported Kerberos Credential Cache",
+				fmt.Sprintf("The provider cannot use the kerberos credential cache: %s.", err),
+			)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa using kerberos credential cache %s", ccachePath))
+	case config.Password.ValueString() == "" && config.ClientCertificate.ValueString() != "" && config.ClientKey.ValueString() != "":
+		tflog.Debug(ctx, "[DEBUG] freeipa using client certificate login")
+	case config.Username.ValueString() == "" && config.Password.ValueString() == "":
+		resp.Diagnostics.AddError(
+			"Missing FreeIPA Credentials",
+			"The provider cannot create the FreeIPA API client as no credentials are configured. "+
+				"Set the username and password values (or the FREEIPA_USERNAME and FREEIPA_PASSWORD environment variables) for password authentication, "+
+				"the keytab and principal values (or the FREEIPA_KEYTAB and FREEIPA_PRINCIPAL environment variables) for keytab authentication, "+
+				"use_ccache (or the FREEIPA_USE_CCACHE environment variable) to use the kerberos credential cache, "+
+				"or client_certificate and client_key (or the FREEIPA_CLIENT_CERT and FREEIPA_CLIENT_KEY environment variables) for certificate authentication.",
+		)
+	default:
+		if config.Username.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing FreeIPA Username",
+				"The provider cannot create the FreeIPA API client as there is a missing or empty value for the FreeIPA username. "+
+					"Set the username value in the configuration or use the FREEIPA_USERNAME environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+
+		if config.Password.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing FreeIPA Password",
+				"The provider cannot create the FreeIPA API client as there is a missing or empty value for the FreeIPA password. "+
+					"Set the password value in the configuration or use the FREEIPA_PASSWORD environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+	}
+
+	if config.MaxConcurrent.IsNull() && os.Getenv("FREEIPA_MAX_CONCURRENT_REQUESTS") != "" {
+		v, err := strconv.ParseInt(os.Getenv("FREEIPA_MAX_CONCURRENT_REQUESTS"), 10, 64)
+		if err != nil || v < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid FreeIPA Max Concurrent Requests",
+				"The FREEIPA_MAX_CONCURRENT_REQUESTS environment variable must be a positive integer.",
+			)
+		}
+		config.MaxConcurrent = types.Int64Value(v)
+	}
+
+	if config.RequestsPerSecond.IsNull() && os.Getenv("FREEIPA_REQUESTS_PER_SECOND") != "" {
+		v, err := strconv.ParseFloat(os.Getenv("FREEIPA_REQUESTS_PER_SECOND"), 64)
+		if err != nil || v <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid FreeIPA Requests Per Second",
+				"The FREEIPA_REQUESTS_PER_SECOND environment variable must be a positive number.",
+			)
+		}
+		config.RequestsPerSecond = types.Float64Value(v)
+	}
+
+	if config.Retry != nil {
+		for name, value := range map[string]types.String{
+			"min_backoff": config.Retry.MinBackoff,
+			"max_backoff": config.Retry.MaxBackoff,
+			"timeout":     config.Retry.Timeout,
+		} {
+			if _, err := time.ParseDuration(value.ValueString()); !value.IsNull() && err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("retry").AtName(name),
+					"Invalid FreeIPA Retry Duration",
+					fmt.Sprintf("The retry %s value must be a duration such as 500ms, 10s or 1m: %s", name, err),
+				)
+			}
+		}
+		// The defaults of the retry policy apply to the backoffs that are not configured.
+		minBackoff, maxBackoff := defaultRetryPolicy().minBackoff, defaultRetryPolicy().maxBackoff
+		var minErr, maxErr error
+		if !config.Retry.MinBackoff.IsNull() {
+			minBackoff, minErr = time.ParseDuration(config.Retry.MinBackoff.ValueString())
+		}
+		if !config.Retry.MaxBackoff.IsNull() {
+			maxBackoff, maxErr = time.ParseDuration(config.Retry.MaxBackoff.ValueString())
+		}
+		if minErr == nil && maxErr == nil && minBackoff > maxBackoff {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry").AtName("min_backoff"),
+				"Invalid FreeIPA Retry Duration",
+				fmt.Sprintf("The retry min_backoff value (%s) must be lower than or equal to the max_backoff value (%s)", minBackoff, maxBackoff),
+			)
+		}
+	}
+
 	if config.InsecureSkipVerify.ValueBool() {
 		resp.Diagnostics.AddAttributeWarning(
 			path.Root("insecure"),
@@ -172,13 +460,23 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 			"The provider will skip TLS verification for the FreeIPA API client and therefore cannot guaranty the security of the connection. ",
 		)
 	}
-	if !config.InsecureSkipVerify.ValueBool() && config.CaCertificate.ValueString() == "" {
+	if config.ProxyUrl.ValueString() != "" {
+		if _, err := url.Parse(config.ProxyUrl.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid FreeIPA Proxy URL",
+				fmt.Sprintf("The proxy URL %s cannot be parsed: %s", config.ProxyUrl.ValueString(), err),
+			)
+		}
+	}
+
+	if !config.InsecureSkipVerify.ValueBool() && config.CaCertificate.ValueString() == "" && config.CaCertificatePem.ValueString() == "" {
 		resp.Diagnostics.AddAttributeWarning(
 			path.Root("ca_certificate"),
 			"Using Host's Root CA Certificates",
 			"The FreeIPA CA Certificate Path is missing or empty, which means the provider will use the host's root CA certificates by default. "+
 				"This may pose a security risk if the host's certificates are not trusted. "+
-				"Set the CA Certificate path in the configuration or use the FREEIPA_CA_CERT environment variable to specify a trusted certificate. "+
+				"Set the CA Certificate path or PEM content in the configuration or use the FREEIPA_CA_CERT or FREEIPA_CA_CERT_PEM environment variables to specify a trusted certificate. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -208,11 +506,18 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 // Client creates a FreeIPA client scoped to the global API
 func (c *freeipaProvider) NewFreeIPAClient(ctx context.Context, conf *freeipaProviderModel) (*ipa.Client, error) {
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa host : %s", conf.Host.ValueString()))
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa hosts : %s", conf.Hosts.String()))
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa discovery domain : %s", conf.DiscoveryDomain.ValueString()))
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa username : %s", conf.Username.ValueString()))
-	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa password : %s", conf.Password.ValueString()))
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa insecure : %s", conf.InsecureSkipVerify.String()))
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa cacert path : %s", conf.CaCertificate.ValueString()))
 
+	servers, err := freeipaServers(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa servers : %v", servers))
+
 	var caCertPool *x509.CertPool
 
 	if conf.CaCertificate.ValueString() != "" {
@@ -221,30 +526,171 @@ func (c *freeipaProvider) NewFreeIPAClient(ctx context.Context, conf *freeipaPro
 			return nil, err
 		}
 		caCertPool = x509.NewCertPool()
-		ok := caCertPool.AppendCertsFromPEM(caCert)
-		if !ok {
-			tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa fail to load cacert at %s", conf.CaCertificate.String()))
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificate could be parsed from the CA certificate file %s", conf.CaCertificate.ValueString())
+		}
+	}
+	if conf.CaCertificatePem.ValueString() != "" {
+		if caCertPool == nil {
+			caCertPool = x509.NewCertPool()
+		}
+		if !caCertPool.AppendCertsFromPEM([]byte(conf.CaCertificatePem.ValueString())) {
+			return nil, fmt.Errorf("no certificate could be parsed from the CA certificate PEM content")
+		}
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if conf.ProxyUrl.ValueString() != "" {
+		proxyUrl, err := url.Parse(conf.ProxyUrl.ValueString())
+		if err != nil {
+			return nil, err
 		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa proxy : %s", proxyUrl.Redacted()))
+		proxy = http.ProxyURL(proxyUrl)
+	}
+
+	var clientCerts []tls.Certificate
+
+	if conf.ClientCertificate.ValueString() != "" {
+		certPEM, err := readPEM(conf.ClientCertificate.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %v", err)
+		}
+		keyPEM, err := readPEM(conf.ClientKey.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %v", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %v", err)
+		}
+		clientCerts = append(clientCerts, cert)
 	}
 
 	// If RootCAs is nil, TLS uses the host's root CA set
 	tspt := &http.Transport{
+		Proxy: proxy,
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: conf.InsecureSkipVerify.ValueBool(),
 			RootCAs:            caCertPool,
+			Certificates:       clientCerts,
 		},
 	}
 
-	client, err := ipa.Connect(conf.Host.ValueString(), tspt, conf.Username.ValueString(), conf.Password.ValueString())
+	auth, err := newAuthenticator(ctx, conf)
 	if err != nil {
 		return nil, err
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] FreeIPA Client configured for host : %s", conf.Host.ValueString()))
+	var base http.RoundTripper = tspt
+	if len(conf.ExtraHeaders.Elements()) > 0 {
+		headers := make(http.Header)
+		for name, value := range conf.ExtraHeaders.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			headers.Set(name, val)
+		}
+		base = &extraHeadersRoundTripper{next: base, headers: headers}
+	}
+	// The limiter is shared by all the resources as they all use the same client.
+	if conf.MaxConcurrent.ValueInt64() > 0 || conf.RequestsPerSecond.ValueFloat64() > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa requests limited to %d concurrent and %f per second", conf.MaxConcurrent.ValueInt64(), conf.RequestsPerSecond.ValueFloat64()))
+		base = newRequestLimiter(base, int(conf.MaxConcurrent.ValueInt64()), conf.RequestsPerSecond.ValueFloat64())
+	}
+
+	transport := newFreeIPATransport(ctx, base, auth, servers)
+	if conf.Retry != nil {
+		if !conf.Retry.MaxAttempts.IsNull() {
+			transport.retry.maxAttempts = int(conf.Retry.MaxAttempts.ValueInt64())
+		}
+		if !conf.Retry.MinBackoff.IsNull() {
+			transport.retry.minBackoff, _ = time.ParseDuration(conf.Retry.MinBackoff.ValueString())
+		}
+		if !conf.Retry.MaxBackoff.IsNull() {
+			transport.retry.maxBackoff, _ = time.ParseDuration(conf.Retry.MaxBackoff.ValueString())
+		}
+		if !conf.Retry.Timeout.IsNull() {
+			transport.retry.timeout, _ = time.ParseDuration(conf.Retry.Timeout.ValueString())
+		}
+		for _, value := range conf.Retry.RetryableErrorCodes.Elements() {
+			code, _ := strconv.Atoi(value.String())
+			transport.retry.errorCodes = append(transport.retry.errorCodes, code)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa retry policy : %+v", transport.retry))
+	transport.trace = conf.TraceRequests.ValueBool()
+
+	client, err := ipa.Connect(servers[0], transport.httpTransport(), conf.Username.ValueString(), conf.Password.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	registerRPCClient(client, servers[0], transport)
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] FreeIPA Client configured for host : %s", servers[0]))
 
 	return client, nil
 }
 
+// freeipaServers returns the FreeIPA servers to connect to, in order of preference: host, hosts and the discovered servers.
+// newAuthenticator returns the authenticator of the configured credentials: keytab first,
+// then the kerberos credential cache, then the client certificate when no password is set, then username and password.
+func newAuthenticator(ctx context.Context, conf *freeipaProviderModel) (freeipaAuthenticator, error) {
+	switch {
+	case conf.Keytab.ValueString() != "":
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa kerberos login with keytab %s as %s", conf.Keytab.ValueString(), conf.Principal.ValueString()))
+		return newKeytabAuthenticator(conf.Principal.ValueString(), conf.Keytab.ValueString(), conf.Krb5Config.ValueString())
+	case conf.UseCCache.ValueBool():
+		ccachePath, err := defaultCCachePath()
+		if err != nil {
+			return nil, err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] freeipa kerberos login with credential cache %s", ccachePath))
+		return newCCacheAuthenticator(ccachePath, conf.Krb5Config.ValueString())
+	case conf.Password.ValueString() == "" && conf.ClientCertificate.ValueString() != "" && conf.ClientKey.ValueString() != "":
+		tflog.Debug(ctx, "[DEBUG] freeipa certificate login")
+		return &certificateAuthenticator{
+			username: conf.Username.ValueString(),
+		}, nil
+	default:
+		return &passwordAuthenticator{
+			username: conf.Username.ValueString(),
+			password: conf.Password.ValueString(),
+		}, nil
+	}
+}
+
+func freeipaServers(ctx context.Context, conf *freeipaProviderModel) ([]string, error) {
+	var servers []string
+
+	if conf.Host.ValueString() != "" {
+		servers = append(servers, conf.Host.ValueString())
+	}
+	for _, value := range conf.Hosts.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		if val != "" && !slices.Contains(servers, val) {
+			servers = append(servers, val)
+		}
+	}
+	if conf.DiscoveryDomain.ValueString() != "" {
+		discovered, err := discoverServers(conf.DiscoveryDomain.ValueString())
+		if err != nil {
+			if len(servers) == 0 {
+				return nil, err
+			}
+			tflog.Warn(ctx, fmt.Sprintf("[WARN] %s", err))
+		}
+		for _, val := range discovered {
+			if !slices.Contains(servers, val) {
+				servers = append(servers, val)
+			}
+		}
+	}
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no FreeIPA server configured")
+	}
+
+	return servers, nil
+}
+
 func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewUserGroupResource,
@@ -253,6 +699,11 @@ func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewHostResource,
 		NewHostGroupResource,
 		NewHostGroupMembershipResource,
+		NewServiceResource,
+		NewHostAllowRetrieveKeytabResource,
+		NewHostAllowCreateKeytabResource,
+		NewServiceAllowRetrieveKeytabResource,
+		NewServiceAllowCreateKeytabResource,
 		NewDNSZoneResource,
 		NewDNSRecordResource,
 		NewSudoCmdResource,
@@ -270,6 +721,46 @@ func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewHbacPolicyHostMembershipResource,
 		NewHbacPolicyUserMembershipResource,
 		NewHbacPolicyServiceMembershipResource,
+		NewHbacServiceResource,
+		NewHbacServiceGroupResource,
+		NewHbacServiceGroupMembershipResource,
+		NewPasswordPolicyResource,
+		NewKerberosTicketPolicyResource,
+		NewCertificateResource,
+		NewCertProfileResource,
+		NewCaResource,
+		NewCaaclResource,
+		NewCaaclUserMembershipResource,
+		NewCaaclHostMembershipResource,
+		NewCaaclServiceMembershipResource,
+		NewCaaclProfileMembershipResource,
+		NewCaaclCaMembershipResource,
+		NewIdViewResource,
+		NewIdViewApplyResource,
+		NewIdOverrideUserResource,
+		NewIdOverrideGroupResource,
+		NewPermissionResource,
+		NewPrivilegeResource,
+		NewPrivilegePermissionMembershipResource,
+		NewRoleResource,
+		NewRolePrivilegeMembershipResource,
+		NewRoleMembershipResource,
+		NewSelfServiceResource,
+		NewDelegationResource,
+		NewNetgroupResource,
+		NewNetgroupMembershipResource,
+		NewSelinuxUsermapResource,
+		NewSelinuxUsermapUserMembershipResource,
+		NewSelinuxUsermapHostMembershipResource,
+		NewAutomountLocationResource,
+		NewAutomountMapResource,
+		NewAutomountKeyResource,
+		NewOtpTokenResource,
+		NewOtpConfigResource,
+		NewRadiusProxyResource,
+		NewIdpResource,
+		NewPasskeyConfigResource,
+		NewUserPasskeyResource,
 		NewAutomemberResource,
 		NewAutomemberConditionResource,
 	}
@@ -280,12 +771,21 @@ func (p *freeipaProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewUserGroupDataSource,
 		NewUserDataSource,
 		NewHostDataSource,
+		NewServiceDataSource,
 		NewHostGroupDataSource,
 		NewDnsZoneDataSource,
 		NewDnsRecordDataSource,
 		NewSudoCmdGroupDataSource,
 		NewSudoRuleDataSource,
 		NewHbacPolicyDataSource,
+		NewHbacServiceDataSource,
+		NewHbacServiceGroupDataSource,
+		NewHbacTestDataSource,
+		NewPasswordPolicyDataSource,
+		NewPermissionDataSource,
+		NewPrivilegeDataSource,
+		NewRoleDataSource,
+		NewAutomountLocationDataSource,
 	}
 }
 
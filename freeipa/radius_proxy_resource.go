@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RadiusProxyResource{}
+var _ resource.ResourceWithImportState = &RadiusProxyResource{}
+
+func NewRadiusProxyResource() resource.Resource {
+	return &RadiusProxyResource{}
+}
+
+// RadiusProxyResource defines the resource implementation.
+type RadiusProxyResource struct {
+	client *ipa.Client
+}
+
+// RadiusProxyResourceModel describes the resource data model.
+type RadiusProxyResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Server        types.String `tfsdk:"server"`
+	Secret        types.String `tfsdk:"secret"`
+	Timeout       types.Int64  `tfsdk:"timeout"`
+	Retries       types.Int64  `tfsdk:"retries"`
+	UserAttribute types.String `tfsdk:"user_attribute"`
+}
+
+func (r *RadiusProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_proxy"
+}
+
+func (r *RadiusProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA RADIUS proxy server resource.\nThe users reference the RADIUS proxy by name with the `radius_proxy_config` attribute. FreeIPA never returns the secret, changes made outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the RADIUS proxy server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "RADIUS proxy server description",
+				Optional:            true,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Hostname or IP address of the RADIUS server, with or without port (ex: `radius.example.lan:1812`)",
+				Required:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret shared with the RADIUS server",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Total time to wait for a response from the RADIUS server (in seconds)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retries": schema.Int64Attribute{
+				MarkdownDescription: "Total number of times to retry the request to the RADIUS server",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"user_attribute": schema.StringAttribute{
+				MarkdownDescription: "LDAP attribute of the user sent as username to the RADIUS server (ex: `mail`)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *RadiusProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RadiusProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RadiusProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.RadiusproxyAddArgs{
+		Cn:                   data.Name.ValueString(),
+		Ipatokenradiusserver: data.Server.ValueString(),
+		Ipatokenradiussecret: data.Secret.ValueString(),
+	}
+	optArgs := ipa.RadiusproxyAddOptionalArgs{
+		Description:              data.Description.ValueStringPointer(),
+		Ipatokenradiustimeout:    intPointer(data.Timeout),
+		Ipatokenradiusretries:    intPointer(data.Retries),
+		Ipatokenusermapattribute: data.UserAttribute.ValueStringPointer(),
+	}
+	_, err := r.client.RadiusproxyAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa radius proxy: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RadiusProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.RadiusproxyShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.RadiusproxyShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.RadiusproxyShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] RADIUS proxy not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa radius proxy: %s", err))
+			return
+		}
+	}
+
+	data.Id = data.Name
+	data.Server = types.StringValue(res.Result.Ipatokenradiusserver)
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipatokenradiustimeout != nil && !data.Timeout.IsNull() {
+		data.Timeout = int64Value(res.Result.Ipatokenradiustimeout)
+	}
+	if res.Result.Ipatokenradiusretries != nil && !data.Retries.IsNull() {
+		data.Retries = int64Value(res.Result.Ipatokenradiusretries)
+	}
+	if res.Result.Ipatokenusermapattribute != nil && !data.UserAttribute.IsNull() {
+		data.UserAttribute = types.StringValue(*res.Result.Ipatokenusermapattribute)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RadiusProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.RadiusproxyModArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.RadiusproxyModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.ValueStringPointer() != nil {
+			optArgs.Description = data.Description.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Description = &v
+		}
+		hasChange = true
+	}
+	if !data.Server.Equal(state.Server) {
+		optArgs.Ipatokenradiusserver = data.Server.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Secret.Equal(state.Secret) {
+		optArgs.Ipatokenradiussecret = data.Secret.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Timeout.Equal(state.Timeout) {
+		if data.Timeout.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipatokenradiustimeout=")
+		} else {
+			optArgs.Ipatokenradiustimeout = intPointer(data.Timeout)
+		}
+		hasChange = true
+	}
+	if !data.Retries.Equal(state.Retries) {
+		if data.Retries.IsNull() {
+			optArgs.Setattr = appendSetattr(optArgs.Setattr, "ipatokenradiusretries=")
+		} else {
+			optArgs.Ipatokenradiusretries = intPointer(data.Retries)
+		}
+		hasChange = true
+	}
+	if !data.UserAttribute.Equal(state.UserAttribute) {
+		if data.UserAttribute.ValueStringPointer() != nil {
+			optArgs.Ipatokenusermapattribute = data.UserAttribute.ValueStringPointer()
+		} else {
+			v := ""
+			optArgs.Ipatokenusermapattribute = &v
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.RadiusproxyMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa radius proxy: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RadiusProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.RadiusproxyDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.RadiusproxyDel(&args, &ipa.RadiusproxyDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa radius proxy: %s", err))
+		return
+	}
+}
+
+func (r *RadiusProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARadiusProxy(t *testing.T) {
+	testRadiusProxy := map[string]string{
+		"index":  "0",
+		"name":   "\"testacc-radius-proxy\"",
+		"server": "\"radius.testacc.ipatest.lan\"",
+		"secret": "\"Secret123\"",
+	}
+	testRadiusProxyModified := map[string]string{
+		"index":          "0",
+		"name":           "\"testacc-radius-proxy\"",
+		"description":    "\"A RADIUS proxy for acceptance tests\"",
+		"server":         "\"radius.testacc.ipatest.lan:1812\"",
+		"secret":         "\"Secret456\"",
+		"timeout":        "10",
+		"retries":        "5",
+		"user_attribute": "\"mail\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "name", "testacc-radius-proxy"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "server", "radius.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "description", "A RADIUS proxy for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "server", "radius.testacc.ipatest.lan:1812"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "timeout", "10"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "retries", "5"),
+					resource.TestCheckResourceAttr("freeipa_radius_proxy.radius-proxy-0", "user_attribute", "mail"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARadiusProxy_resource(testRadiusProxy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_radius_proxy.radius-proxy-0", "description"),
+					resource.TestCheckNoResourceAttr("freeipa_radius_proxy.radius-proxy-0", "timeout"),
+					resource.TestCheckNoResourceAttr("freeipa_radius_proxy.radius-proxy-0", "user_attribute"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoleDataSource{}
+var _ datasource.DataSourceWithConfigure = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+// RoleDataSource defines the data source implementation.
+type RoleDataSource struct {
+	client *ipa.Client
+}
+
+// RoleDataSourceModel describes the data source data model.
+type RoleDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	MemberOfPrivilege types.List   `tfsdk:"memberof_privilege"`
+	MemberUser        types.List   `tfsdk:"member_user"`
+	MemberGroup       types.List   `tfsdk:"member_group"`
+	MemberHost        types.List   `tfsdk:"member_host"`
+	MemberHostgroup   types.List   `tfsdk:"member_hostgroup"`
+	MemberService     types.List   `tfsdk:"member_service"`
+}
+
+func (r *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA role data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the role",
+				Computed:            true,
+			},
+			"memberof_privilege": schema.ListAttribute{
+				MarkdownDescription: "List of privileges granted by the role",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_user": schema.ListAttribute{
+				MarkdownDescription: "List of users the role is assigned to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_group": schema.ListAttribute{
+				MarkdownDescription: "List of user groups the role is assigned to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_host": schema.ListAttribute{
+				MarkdownDescription: "List of hosts the role is assigned to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_hostgroup": schema.ListAttribute{
+				MarkdownDescription: "List of host groups the role is assigned to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"member_service": schema.ListAttribute{
+				MarkdownDescription: "List of services the role is assigned to",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.RoleShowArgs{
+		Cn: data.Name.ValueString(),
+	}
+	optArgs := ipa.RoleShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.RoleShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa role %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa role %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Description != nil {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.MemberofPrivilege != nil {
+		data.MemberOfPrivilege, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberofPrivilege)
+	}
+	if res.Result.MemberUser != nil {
+		data.MemberUser, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberUser)
+	}
+	if res.Result.MemberGroup != nil {
+		data.MemberGroup, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberGroup)
+	}
+	if res.Result.MemberHost != nil {
+		data.MemberHost, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberHost)
+	}
+	if res.Result.MemberHostgroup != nil {
+		data.MemberHostgroup, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberHostgroup)
+	}
+	if res.Result.MemberService != nil {
+		data.MemberService, _ = types.ListValueFrom(ctx, types.StringType, res.Result.MemberService)
+	}
+	data.Id = types.StringValue(res.Result.Cn)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,779 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleMembershipResource{}
+var _ resource.ResourceWithImportState = &RoleMembershipResource{}
+
+func NewRoleMembershipResource() resource.Resource {
+	return &RoleMembershipResource{}
+}
+
+// RoleMembershipResource defines the resource implementation.
+type RoleMembershipResource struct {
+	client *ipa.Client
+}
+
+// RoleMembershipResourceModel describes the resource data model.
+type RoleMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Users      types.List   `tfsdk:"users"`
+	Group      types.String `tfsdk:"group"`
+	Groups     types.List   `tfsdk:"groups"`
+	Host       types.String `tfsdk:"host"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	Hostgroup  types.String `tfsdk:"hostgroup"`
+	Hostgroups types.List   `tfsdk:"hostgroups"`
+	Service    types.String `tfsdk:"service"`
+	Services   types.List   `tfsdk:"services"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *RoleMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *RoleMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("host"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("service"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("services"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("service"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("services"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("service"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("services"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("service"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("services"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("service"),
+			path.MatchRoot("services"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("service"),
+			path.MatchRoot("users"),
+			path.MatchRoot("groups"),
+			path.MatchRoot("hosts"),
+			path.MatchRoot("hostgroups"),
+			path.MatchRoot("services"),
+		),
+	}
+}
+
+func (r *RoleMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA role membership resource.\nAssigns a role to users, user groups, hosts, host groups and services.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "List of users to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Host group to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Service principal to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"services": schema.ListAttribute{
+				MarkdownDescription: "List of service principals to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple role membership resources on the same role. Mandatory for using users/groups/hosts/hostgroups/services configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.RoleAddMemberOptionalArgs{}
+
+	args := ipa.RoleAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+		id = fmt.Sprintf("%s/u/%s", encodeSlash(data.Name.ValueString()), data.User.ValueString())
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+		id = fmt.Sprintf("%s/g/%s", encodeSlash(data.Name.ValueString()), data.Group.ValueString())
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+		id = fmt.Sprintf("%s/h/%s", encodeSlash(data.Name.ValueString()), data.Host.ValueString())
+	}
+	if !data.Hostgroup.IsNull() {
+		v := []string{data.Hostgroup.ValueString()}
+		optArgs.Hostgroup = &v
+		id = fmt.Sprintf("%s/hg/%s", encodeSlash(data.Name.ValueString()), data.Hostgroup.ValueString())
+	}
+	if !data.Service.IsNull() {
+		v := []string{data.Service.ValueString()}
+		optArgs.Service = &v
+		id = fmt.Sprintf("%s/s/%s", encodeSlash(data.Name.ValueString()), data.Service.ValueString())
+	}
+	if !data.Users.IsNull() || !data.Groups.IsNull() || !data.Hosts.IsNull() || !data.Hostgroups.IsNull() || !data.Services.IsNull() {
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+		if !data.Services.IsNull() {
+			v := listStringValues(data.Services)
+			optArgs.Service = &v
+		}
+		id = fmt.Sprintf("%s/m/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.RoleAddMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa role membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa role membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId, typeId, memberId, err := parseRoleMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_role_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.RoleShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.RoleShowArgs{
+		Cn: roleId,
+	}
+
+	res, err := r.client.RoleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Role not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa role: %s", err))
+			return
+		}
+	}
+
+	services := servicePrincipalsWithoutRealm(res.Result.MemberService)
+
+	switch typeId {
+	case "u":
+		if res.Result.MemberUser == nil || !isStringListContainsCaseInsensistive(res.Result.MemberUser, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Role user membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "g":
+		if res.Result.MemberGroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberGroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Role group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "h":
+		if res.Result.MemberHost == nil || !isStringListContainsCaseInsensistive(res.Result.MemberHost, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Role host membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "hg":
+		if res.Result.MemberHostgroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberHostgroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Role host group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "s":
+		if !isStringListContainsCaseInsensistive(&services, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] Role service membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "m":
+		var diag diag.Diagnostics
+		if !data.Users.IsNull() {
+			data.Users, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Users, res.Result.MemberUser))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Groups.IsNull() {
+			data.Groups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Groups, res.Result.MemberGroup))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hosts.IsNull() {
+			data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hosts, res.Result.MemberHost))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Hostgroups.IsNull() {
+			data.Hostgroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Hostgroups, res.Result.MemberHostgroup))
+			resp.Diagnostics.Append(diag...)
+		}
+		if !data.Services.IsNull() {
+			data.Services, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Services, &services))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RoleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RoleMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.RoleAddMemberOptionalArgs{}
+
+	memberAddArgs := ipa.RoleAddMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.RoleRemoveMemberOptionalArgs{}
+
+	memberDelArgs := ipa.RoleRemoveMemberArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Users.Equal(state.Users) {
+		addedUsers, deletedUsers := diffStringLists(state.Users, data.Users)
+		if len(addedUsers) > 0 {
+			memberAddOptArgs.User = &addedUsers
+			hasMemberAdd = true
+		}
+		if len(deletedUsers) > 0 {
+			memberDelOptArgs.User = &deletedUsers
+			hasMemberDel = true
+		}
+	}
+	if !data.Groups.Equal(state.Groups) {
+		addedGroups, deletedGroups := diffStringLists(state.Groups, data.Groups)
+		if len(addedGroups) > 0 {
+			memberAddOptArgs.Group = &addedGroups
+			hasMemberAdd = true
+		}
+		if len(deletedGroups) > 0 {
+			memberDelOptArgs.Group = &deletedGroups
+			hasMemberDel = true
+		}
+	}
+	if !data.Hosts.Equal(state.Hosts) {
+		addedHosts, deletedHosts := diffStringLists(state.Hosts, data.Hosts)
+		if len(addedHosts) > 0 {
+			memberAddOptArgs.Host = &addedHosts
+			hasMemberAdd = true
+		}
+		if len(deletedHosts) > 0 {
+			memberDelOptArgs.Host = &deletedHosts
+			hasMemberDel = true
+		}
+	}
+	if !data.Hostgroups.Equal(state.Hostgroups) {
+		addedHostgroups, deletedHostgroups := diffStringLists(state.Hostgroups, data.Hostgroups)
+		if len(addedHostgroups) > 0 {
+			memberAddOptArgs.Hostgroup = &addedHostgroups
+			hasMemberAdd = true
+		}
+		if len(deletedHostgroups) > 0 {
+			memberDelOptArgs.Hostgroup = &deletedHostgroups
+			hasMemberDel = true
+		}
+	}
+	if !data.Services.Equal(state.Services) {
+		addedServices, deletedServices := diffStringLists(state.Services, data.Services)
+		if len(addedServices) > 0 {
+			memberAddOptArgs.Service = &addedServices
+			hasMemberAdd = true
+		}
+		if len(deletedServices) > 0 {
+			memberDelOptArgs.Service = &deletedServices
+			hasMemberDel = true
+		}
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.RoleAddMember(&memberAddArgs, &memberAddOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa role membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa role membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa role membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.RoleRemoveMember(&memberDelArgs, &memberDelOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa role membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa role membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa role membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleId, typeId, memberId, err := parseRoleMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_role_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.RoleRemoveMemberOptionalArgs{}
+
+	args := ipa.RoleRemoveMemberArgs{
+		Cn: roleId,
+	}
+
+	switch typeId {
+	case "u":
+		v := []string{memberId}
+		optArgs.User = &v
+	case "g":
+		v := []string{memberId}
+		optArgs.Group = &v
+	case "h":
+		v := []string{memberId}
+		optArgs.Host = &v
+	case "hg":
+		v := []string{memberId}
+		optArgs.Hostgroup = &v
+	case "s":
+		v := []string{memberId}
+		optArgs.Service = &v
+	case "m":
+		if !data.Users.IsNull() {
+			v := listStringValues(data.Users)
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			v := listStringValues(data.Groups)
+			optArgs.Group = &v
+		}
+		if !data.Hosts.IsNull() {
+			v := listStringValues(data.Hosts)
+			optArgs.Host = &v
+		}
+		if !data.Hostgroups.IsNull() {
+			v := listStringValues(data.Hostgroups)
+			optArgs.Hostgroup = &v
+		}
+		if !data.Services.IsNull() {
+			v := listStringValues(data.Services)
+			optArgs.Service = &v
+		}
+	}
+
+	_, err = r.client.RoleRemoveMember(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa role membership: %s", err))
+		return
+	}
+}
+
+func (r *RoleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleId, typeId, memberId, err := parseRoleMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.RoleShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.RoleShowArgs{
+		Cn: roleId,
+	}
+
+	res, err := r.client.RoleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Role not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), roleId)...)
+
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "s":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), memberId)...)
+	case "m":
+		// The imported membership manages all the current members of the role.
+		var users, groups, hosts, hostgroups, services []string
+		if res.Result.MemberUser != nil {
+			users = *res.Result.MemberUser
+		}
+		if res.Result.MemberGroup != nil {
+			groups = *res.Result.MemberGroup
+		}
+		if res.Result.MemberHost != nil {
+			hosts = *res.Result.MemberHost
+		}
+		if res.Result.MemberHostgroup != nil {
+			hostgroups = *res.Result.MemberHostgroup
+		}
+		if res.Result.MemberService != nil {
+			for _, principal := range *res.Result.MemberService {
+				services = append(services, strings.SplitN(principal, "@", 2)[0])
+			}
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), users)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), groups)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hosts"), hosts)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroups"), hostgroups)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("services"), services)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseRoleMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine role membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	member := idParts[2]
+
+	return name, _type, member, nil
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARoleMembership_simple(t *testing.T) {
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_role.role-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPARoleMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "name", "testacc-role"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "user", "testacc-user"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPARoleMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPARoleMembership_multiple(t *testing.T) {
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group\"",
+	}
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"groups":     "[freeipa_group.group-0.name]",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"identifier": "\"members-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"groups":     "[freeipa_group.group-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"services":   "[freeipa_service.service-0.name]",
+		"identifier": "\"members-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAService_resource(testService) + testAccFreeIPARoleMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "name", "testacc-role"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "hosts.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAService_resource(testService) + testAccFreeIPARoleMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_role_membership.role-membership-0", "users"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "groups.#", "1"),
+					resource.TestCheckNoResourceAttr("freeipa_role_membership.role-membership-0", "hosts"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "hostgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_role_membership.role-membership-0", "services.0", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				ResourceName:      "freeipa_role_membership.role-membership-0",
+				ImportState:       true,
+				ImportStateId:     "testacc-role/m/members-0",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAService_resource(testService) + testAccFreeIPARoleMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RolePrivilegeMembershipResource{}
+var _ resource.ResourceWithImportState = &RolePrivilegeMembershipResource{}
+
+func NewRolePrivilegeMembershipResource() resource.Resource {
+	return &RolePrivilegeMembershipResource{}
+}
+
+// RolePrivilegeMembershipResource defines the resource implementation.
+type RolePrivilegeMembershipResource struct {
+	client *ipa.Client
+}
+
+// RolePrivilegeMembershipResourceModel describes the resource data model.
+type RolePrivilegeMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Privilege  types.String `tfsdk:"privilege"`
+	Privileges types.List   `tfsdk:"privileges"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *RolePrivilegeMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_privilege_membership"
+}
+
+func (r *RolePrivilegeMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("privilege"),
+			path.MatchRoot("privileges"),
+		),
+	}
+}
+
+func (r *RolePrivilegeMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA role privilege membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privilege": schema.StringAttribute{
+				MarkdownDescription: "Privilege to add to the role",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "List of privileges to add to the role",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("identifier")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple role privilege membership resources on the same role. Mandatory for using privileges configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RolePrivilegeMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RolePrivilegeMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RolePrivilegeMembershipResourceModel
+	var id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.RoleAddPrivilegeOptionalArgs{}
+
+	args := ipa.RoleAddPrivilegeArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Privilege.IsNull() {
+		v := []string{data.Privilege.ValueString()}
+		optArgs.Privilege = &v
+		id = fmt.Sprintf("%s/p/%s", encodeSlash(data.Name.ValueString()), data.Privilege.ValueString())
+	}
+	if !data.Privileges.IsNull() {
+		v := listStringValues(data.Privileges)
+		optArgs.Privilege = &v
+		id = fmt.Sprintf("%s/mp/%s", encodeSlash(data.Name.ValueString()), data.Identifier.ValueString())
+	}
+
+	_v, err := r.client.RoleAddPrivilege(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa role privilege membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa role privilege membership: %v", _v.Failed))
+	}
+	data.Id = types.StringValue(id)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RolePrivilegeMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RolePrivilegeMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId, typeId, memberId, err := parseRolePrivilegeMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_role_privilege_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.RoleShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.RoleShowArgs{
+		Cn: roleId,
+	}
+
+	res, err := r.client.RoleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Role not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa role: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "p":
+		if res.Result.MemberofPrivilege == nil || !isStringListContainsCaseInsensistive(res.Result.MemberofPrivilege, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] role privilege membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mp":
+		if !data.Privileges.IsNull() {
+			var diag diag.Diagnostics
+			data.Privileges, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.Privileges, res.Result.MemberofPrivilege))
+			resp.Diagnostics.Append(diag...)
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RolePrivilegeMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RolePrivilegeMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	addedPrivileges, deletedPrivileges := diffStringLists(state.Privileges, data.Privileges)
+
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if len(addedPrivileges) > 0 {
+		args := ipa.RoleAddPrivilegeArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.RoleAddPrivilege(&args, &ipa.RoleAddPrivilegeOptionalArgs{Privilege: &addedPrivileges})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa role privilege membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa role privilege membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa role privilege membership: %v", _v.Failed))
+		}
+	}
+	if len(deletedPrivileges) > 0 {
+		args := ipa.RoleRemovePrivilegeArgs{
+			Cn: data.Name.ValueString(),
+		}
+		_v, err := r.client.RoleRemovePrivilege(&args, &ipa.RoleRemovePrivilegeOptionalArgs{Privilege: &deletedPrivileges})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa role privilege membership: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa role privilege membership: %s", _v.String()))
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa role privilege membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RolePrivilegeMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RolePrivilegeMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleId, typeId, memberId, err := parseRolePrivilegeMembershipID(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_role_privilege_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.RoleRemovePrivilegeOptionalArgs{}
+
+	args := ipa.RoleRemovePrivilegeArgs{
+		Cn: roleId,
+	}
+
+	switch typeId {
+	case "p":
+		v := []string{memberId}
+		optArgs.Privilege = &v
+	case "mp":
+		v := listStringValues(data.Privileges)
+		optArgs.Privilege = &v
+	}
+
+	_, err = r.client.RoleRemovePrivilege(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa role privilege membership: %s", err))
+		return
+	}
+}
+
+func (r *RolePrivilegeMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleId, typeId, memberId, err := parseRolePrivilegeMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.RoleShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.RoleShowArgs{
+		Cn: roleId,
+	}
+
+	res, err := r.client.RoleShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "Role not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), roleId)...)
+
+	switch typeId {
+	case "p":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("privilege"), memberId)...)
+	case "mp":
+		// The imported membership manages all the privileges currently member of the role.
+		var privileges []string
+		if res.Result.MemberofPrivilege != nil {
+			privileges = *res.Result.MemberofPrivilege
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("privileges"), privileges)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseRolePrivilegeMembershipID(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine role privilege membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	privilege := idParts[2]
+
+	return name, _type, privilege, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPARolePrivilegeMembership_simple(t *testing.T) {
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testMembership := map[string]string{
+		"index":     "0",
+		"name":      "freeipa_role.role-0.name",
+		"privilege": "freeipa_privilege.privilege-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPARolePrivilegeMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "name", "testacc-role"),
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privilege", "testacc-privilege"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPARolePrivilegeMembership_resource(testMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPARolePrivilegeMembership_multiple(t *testing.T) {
+	testRole := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-role\"",
+	}
+	testPrivilege := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-privilege\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"privileges": "[freeipa_privilege.privilege-0.name, \"User Administrators\"]",
+		"identifier": "\"privileges-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_role.role-0.name",
+		"privileges": "[\"User Administrators\", \"Group Administrators\"]",
+		"identifier": "\"privileges-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPARolePrivilegeMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "name", "testacc-role"),
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPARolePrivilegeMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_role_privilege_membership.role-privilege-membership-0", "privileges.1", "Group Administrators"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPARole_resource(testRole) + testAccFreeIPAPrivilege_resource(testPrivilege) + testAccFreeIPARolePrivilegeMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
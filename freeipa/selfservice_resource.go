@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SelfServiceResource{}
+var _ resource.ResourceWithImportState = &SelfServiceResource{}
+
+func NewSelfServiceResource() resource.Resource {
+	return &SelfServiceResource{}
+}
+
+// SelfServiceResource defines the resource implementation.
+type SelfServiceResource struct {
+	client *ipa.Client
+}
+
+// SelfServiceResourceModel describes the resource data model.
+type SelfServiceResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Attributes  types.Set    `tfsdk:"attributes"`
+}
+
+func (r *SelfServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selfservice"
+}
+
+func (r *SelfServiceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *SelfServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA self-service permission resource.\nA self-service permission defines the attributes users are allowed to manage on their own entry.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the self-service permission",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions to grant (`read`, `write`). Defaults to `write` in FreeIPA.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write")),
+				},
+			},
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes the users are allowed to manage on their own entry",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SelfServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SelfServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SelfServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SelfserviceAddOptionalArgs{}
+
+	args := ipa.SelfserviceAddArgs{
+		Aciname: data.Name.ValueString(),
+		Attrs:   setStringValues(data.Attributes),
+	}
+
+	if len(data.Permissions.Elements()) > 0 {
+		v := setStringValues(data.Permissions)
+		optArgs.Permissions = &v
+	}
+
+	_, err := r.client.SelfserviceAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa self-service permission: %s", err))
+		return
+	}
+	data.Id = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelfServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SelfServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.SelfserviceShowArgs{
+		Aciname: data.Name.ValueString(),
+	}
+	optArgs := ipa.SelfserviceShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.SelfserviceShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Self-service permission not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa self-service permission: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa self-service permission %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa self-service permission %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Permissions != nil && !data.Permissions.IsNull() {
+		data.Permissions, _ = types.SetValueFrom(ctx, types.StringType, res.Result.Permissions)
+	}
+	data.Attributes, _ = types.SetValueFrom(ctx, types.StringType, res.Result.Attrs)
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SelfServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SelfServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SelfserviceModOptionalArgs{}
+
+	args := ipa.SelfserviceModArgs{
+		Aciname: data.Name.ValueString(),
+	}
+
+	var hasChange = false
+
+	if !data.Permissions.Equal(state.Permissions) {
+		// FreeIPA falls back to the write permission when the attribute is removed from the configuration.
+		v := []string{"write"}
+		if len(data.Permissions.Elements()) > 0 {
+			v = setStringValues(data.Permissions)
+		}
+		optArgs.Permissions = &v
+		hasChange = true
+	}
+	if !data.Attributes.Equal(state.Attributes) {
+		v := setStringValues(data.Attributes)
+		optArgs.Attrs = &v
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.SelfserviceMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa self-service permission: %s", err))
+				return
+			}
+		}
+	}
+	data.Id = data.Name
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelfServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SelfServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa self-service permission %s", data.Name.ValueString()))
+	args := ipa.SelfserviceDelArgs{
+		Aciname: data.Name.ValueString(),
+	}
+	optArgs := ipa.SelfserviceDelOptionalArgs{}
+	_, err := r.client.SelfserviceDel(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Self-service permission %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *SelfServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
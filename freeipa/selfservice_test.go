@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASelfService_full(t *testing.T) {
+	testSelfService := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-selfservice\"",
+		"attributes": "[\"telephonenumber\"]",
+	}
+	testSelfServiceModified := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selfservice\"",
+		"permissions": "[\"read\", \"write\"]",
+		"attributes":  "[\"telephonenumber\", \"mobile\"]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelfService_resource(testSelfService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selfservice.selfservice-0", "name", "testacc-selfservice"),
+					resource.TestCheckResourceAttr("freeipa_selfservice.selfservice-0", "attributes.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelfService_resource(testSelfServiceModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selfservice.selfservice-0", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_selfservice.selfservice-0", "attributes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_selfservice.selfservice-0", "attributes.*", "mobile"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelfService_resource(testSelfServiceModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
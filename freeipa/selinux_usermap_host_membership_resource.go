@@ -0,0 +1,531 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SelinuxUsermapHostMembershipResource{}
+var _ resource.ResourceWithImportState = &SelinuxUsermapHostMembershipResource{}
+
+func NewSelinuxUsermapHostMembershipResource() resource.Resource {
+	return &SelinuxUsermapHostMembershipResource{}
+}
+
+// SelinuxUsermapHostMembershipResource defines the resource implementation.
+type SelinuxUsermapHostMembershipResource struct {
+	client *ipa.Client
+}
+
+// SelinuxUsermapHostMembershipResourceModel describes the resource data model.
+type SelinuxUsermapHostMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Host       types.String `tfsdk:"host"`
+	Hosts      types.List   `tfsdk:"hosts"`
+	HostGroup  types.String `tfsdk:"hostgroup"`
+	HostGroups types.List   `tfsdk:"hostgroups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selinux_usermap_host_membership"
+}
+
+func (r *SelinuxUsermapHostMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroup"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("host"),
+			path.MatchRoot("hostgroups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hosts"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("hostgroup"),
+			path.MatchRoot("hostgroups"),
+		),
+	}
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA SELinux user map host membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "SELinux user map name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host to add to the SELinux user map",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "List of hosts to add to the SELinux user map",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Host group to add to the SELinux user map",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostgroups": schema.ListAttribute{
+				MarkdownDescription: "List of host groups to add to the SELinux user map",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple SELinux user map host membership resources on the same SELinux user map. Mandatory for using hosts/hostgroups configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SelinuxUsermapHostMembershipResourceModel
+	var id, cmd_id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SelinuxusermapAddHostOptionalArgs{}
+
+	args := ipa.SelinuxusermapAddHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.Host.IsNull() {
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+		cmd_id = "h"
+	}
+	if !data.HostGroup.IsNull() {
+		v := []string{data.HostGroup.ValueString()}
+		optArgs.Hostgroup = &v
+		cmd_id = "hg"
+	}
+	if !data.Hosts.IsNull() || !data.HostGroups.IsNull() {
+		if !data.Hosts.IsNull() {
+			var v []string
+			for _, value := range data.Hosts.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Host = &v
+		}
+		if !data.HostGroups.IsNull() {
+			var v []string
+			for _, value := range data.HostGroups.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Hostgroup = &v
+		}
+		cmd_id = "mh"
+	}
+
+	_v, err := r.client.SelinuxusermapAddHost(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa selinux user map host membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa selinux user map host membership: %v", _v.Failed))
+	}
+
+	switch cmd_id {
+	case "h":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), cmd_id, data.Host.ValueString())
+		data.Id = types.StringValue(id)
+	case "hg":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), cmd_id, data.HostGroup.ValueString())
+		data.Id = types.StringValue(id)
+	case "mh":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), cmd_id, data.Identifier.ValueString())
+		data.Id = types.StringValue(id)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SelinuxUsermapHostMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usermapid, typeId, memberId, err := parseSelinuxUsermapHostMembershipID(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_selinux_usermap_host_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: usermapid,
+	}
+
+	res, err := r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "h":
+		if res.Result.MemberhostHost == nil || !isStringListContainsCaseInsensistive(res.Result.MemberhostHost, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map host membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "hg":
+		if res.Result.MemberhostHostgroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberhostHostgroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map host group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mh":
+		if !data.Hosts.IsNull() {
+			var changedVals []string
+			for _, value := range data.Hosts.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map host member failed with error %s", err))
+				}
+				if res.Result.MemberhostHost != nil && isStringListContainsCaseInsensistive(res.Result.MemberhostHost, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map host member %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.Hosts, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
+		if !data.HostGroups.IsNull() {
+			var changedVals []string
+			for _, value := range data.HostGroups.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map member commands failed with error %s", err))
+				}
+				if res.Result.MemberhostHostgroup != nil && isStringListContainsCaseInsensistive(res.Result.MemberhostHostgroup, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map member commands %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.HostGroups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SelinuxUsermapHostMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.SelinuxusermapAddHostOptionalArgs{}
+
+	memberAddArgs := ipa.SelinuxusermapAddHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.SelinuxusermapRemoveHostOptionalArgs{}
+
+	memberDelArgs := ipa.SelinuxusermapRemoveHostArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Hosts.Equal(state.Hosts) {
+		var statearr, planarr, addedHosts, deletedHosts []string
+
+		for _, value := range state.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Hosts.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedHosts = append(addedHosts, val)
+				memberAddOptArgs.Host = &addedHosts
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedHosts = append(deletedHosts, value)
+				memberDelOptArgs.Host = &deletedHosts
+				hasMemberDel = true
+			}
+		}
+
+	}
+	if !data.HostGroups.Equal(state.HostGroups) {
+		var statearr, planarr, addedCmdGrps, deletedCmdGrps []string
+
+		for _, value := range state.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.HostGroups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedCmdGrps = append(addedCmdGrps, val)
+				memberAddOptArgs.Hostgroup = &addedCmdGrps
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedCmdGrps = append(deletedCmdGrps, value)
+				memberDelOptArgs.Hostgroup = &deletedCmdGrps
+				hasMemberDel = true
+			}
+		}
+
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.SelinuxusermapAddHost(&memberAddArgs, &memberAddOptArgs)
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Error creating freeipa selinux user map host membership: %s", _v.String()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa selinux user map host membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa selinux user map host membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.SelinuxusermapRemoveHost(&memberDelArgs, &memberDelOptArgs)
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Error removing freeipa selinux user map host membership: %s", _v.String()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa selinux user map host membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa selinux user map host membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapHostMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SelinuxUsermapHostMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	usermapId, typeId, _, err := parseSelinuxUsermapHostMembershipID(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_selinux_usermap_host_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.SelinuxusermapRemoveHostOptionalArgs{}
+
+	args := ipa.SelinuxusermapRemoveHostArgs{
+		Cn: usermapId,
+	}
+
+	switch typeId {
+	case "h":
+		v := []string{data.Host.ValueString()}
+		optArgs.Host = &v
+	case "hg":
+		v := []string{data.HostGroup.ValueString()}
+		optArgs.Hostgroup = &v
+	case "mh":
+		if !data.Hosts.IsNull() {
+			var v []string
+			for _, value := range data.Hosts.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Host = &v
+		}
+		if !data.HostGroups.IsNull() {
+			var v []string
+			for _, value := range data.HostGroups.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Hostgroup = &v
+		}
+	}
+
+	_, err = r.client.SelinuxusermapRemoveHost(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa selinux user map host membership: %s", err))
+		return
+	}
+}
+
+func (r *SelinuxUsermapHostMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	usermapId, typeId, memberId, err := parseSelinuxUsermapHostMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: usermapId,
+	}
+
+	_, err = r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "SELinux user map not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), usermapId)...)
+
+	switch typeId {
+	case "h":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), memberId)...)
+	case "hg":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), memberId)...)
+	case "mh":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseSelinuxUsermapHostMembershipID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine host membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	host := idParts[2]
+
+	return name, _type, host, nil
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASelinuxUsermapHostMembership_simple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"staff_u:s0-s0:c0.c1023\"",
+	}
+	testHostMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"host":  "freeipa_host.host-0.name",
+	}
+	testHostGroupMembership := map[string]string{
+		"index":     "1",
+		"name":      "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"hostgroup": "freeipa_hostgroup.hostgroup-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostMembership) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "name", "testacc-selinux-usermap"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "host", "testacc-host-1.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-1", "hostgroup", "testacc-hostgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostMembership) + testAccFreeIPASelinuxUsermapHostMembership_resource(testHostGroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASelinuxUsermapHostMembership_multiple(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"staff_u:s0-s0:c0.c1023\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"identifier": "\"hosts-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"hosts":      "[freeipa_host.host-0.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"hosts-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapHostMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hosts.0", "testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapHostMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hostgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_host_membership.selinux-usermap-host-membership-0", "hostgroups.0", "testacc-hostgroup"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapHostMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SelinuxUsermapResource{}
+var _ resource.ResourceWithImportState = &SelinuxUsermapResource{}
+
+func NewSelinuxUsermapResource() resource.Resource {
+	return &SelinuxUsermapResource{}
+}
+
+// SelinuxUsermapResource defines the resource implementation.
+type SelinuxUsermapResource struct {
+	client *ipa.Client
+}
+
+// SelinuxUsermapResourceModel describes the resource data model.
+type SelinuxUsermapResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	SelinuxUser  types.String `tfsdk:"selinuxuser"`
+	HbacRule     types.String `tfsdk:"hbac_rule"`
+	Description  types.String `tfsdk:"description"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	UserCategory types.String `tfsdk:"usercategory"`
+	HostCategory types.String `tfsdk:"hostcategory"`
+}
+
+func (r *SelinuxUsermapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selinux_usermap"
+}
+
+func (r *SelinuxUsermapResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func (r *SelinuxUsermapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA SELinux user map resource.\nA SELinux user map linked to an HBAC rule takes its users and hosts from that rule, it cannot have categories or members of its own.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SELinux user map",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selinuxuser": schema.StringAttribute{
+				MarkdownDescription: "SELinux user the members are mapped to (ex: `staff_u:s0-s0:c0.c1023`)",
+				Required:            true,
+			},
+			"hbac_rule": schema.StringAttribute{
+				MarkdownDescription: "HBAC rule defining the users and hosts the SELinux user map is applied to",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "SELinux user map description",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable this SELinux user map",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the SELinux user map is applied to (allowed value: all)",
+				Optional:            true,
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the SELinux user map is applied to (allowed value: all)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SelinuxUsermapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SelinuxUsermapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SelinuxUsermapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SelinuxusermapAddOptionalArgs{}
+
+	args := ipa.SelinuxusermapAddArgs{
+		Cn:             data.Name.ValueString(),
+		Ipaselinuxuser: data.SelinuxUser.ValueString(),
+	}
+	if !data.HbacRule.IsNull() {
+		optArgs.Seealso = data.HbacRule.ValueStringPointer()
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = data.Description.ValueStringPointer()
+	}
+	if !data.Enabled.IsNull() {
+		v := data.Enabled.ValueBool()
+		optArgs.Ipaenabledflag = &v
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+	}
+	_, err := r.client.SelinuxusermapAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa selinux user map: %s", err))
+		return
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SelinuxUsermapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: data.Id.ValueString(),
+	}
+
+	res, err := r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+			return
+		}
+	}
+
+	data.SelinuxUser = types.StringValue(res.Result.Ipaselinuxuser)
+	if res.Result.Seealso != nil && !data.HbacRule.IsNull() {
+		data.HbacRule = types.StringValue(*res.Result.Seealso)
+	}
+	if res.Result.Description != nil && !data.Description.IsNull() {
+		data.Description = types.StringValue(*res.Result.Description)
+	}
+	if res.Result.Ipaenabledflag != nil && !data.Enabled.IsNull() {
+		data.Enabled = types.BoolValue(*res.Result.Ipaenabledflag)
+	}
+	if res.Result.Usercategory != nil && !data.UserCategory.IsNull() {
+		data.UserCategory = types.StringValue(*res.Result.Usercategory)
+	}
+	if res.Result.Hostcategory != nil && !data.HostCategory.IsNull() {
+		data.HostCategory = types.StringValue(*res.Result.Hostcategory)
+	}
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SelinuxUsermapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SelinuxUsermapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.SelinuxusermapModArgs{
+		Cn: data.Id.ValueString(),
+	}
+	optArgs := ipa.SelinuxusermapModOptionalArgs{}
+
+	var hasChange = false
+
+	if !data.SelinuxUser.Equal(state.SelinuxUser) {
+		optArgs.Ipaselinuxuser = data.SelinuxUser.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.HbacRule.Equal(state.HbacRule) {
+		if data.HbacRule.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Seealso = &v
+		} else {
+			optArgs.Seealso = data.HbacRule.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.Description.Equal(state.Description) {
+		optArgs.Description = data.Description.ValueStringPointer()
+		hasChange = true
+	}
+	if !data.Enabled.Equal(state.Enabled) {
+		if !data.Enabled.ValueBool() {
+			_, err := r.client.SelinuxusermapDisable(&ipa.SelinuxusermapDisableArgs{Cn: data.Id.ValueString()}, &ipa.SelinuxusermapDisableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disabling freeipa selinux user map: %s", err))
+			}
+		} else {
+			_, err := r.client.SelinuxusermapEnable(&ipa.SelinuxusermapEnableArgs{Cn: data.Id.ValueString()}, &ipa.SelinuxusermapEnableOptionalArgs{})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error enabling freeipa selinux user map: %s", err))
+			}
+		}
+	}
+	if !data.UserCategory.Equal(state.UserCategory) {
+		if data.UserCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Usercategory = &v
+		} else {
+			optArgs.Usercategory = data.UserCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+	if !data.HostCategory.Equal(state.HostCategory) {
+		if data.HostCategory.ValueStringPointer() == nil {
+			v := ""
+			optArgs.Hostcategory = &v
+		} else {
+			optArgs.Hostcategory = data.HostCategory.ValueStringPointer()
+		}
+		hasChange = true
+	}
+
+	if hasChange {
+		_, err := r.client.SelinuxusermapMod(&args, &optArgs)
+		if err != nil {
+			if strings.Contains(err.Error(), "EmptyModlist") {
+				resp.Diagnostics.AddWarning("Client Warning", err.Error())
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error update freeipa selinux user map: %s", err))
+				return
+			}
+		}
+	}
+
+	data.Id = data.Name
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SelinuxUsermapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.SelinuxusermapDelArgs{
+		Cn: []string{data.Id.ValueString()},
+	}
+	_, err := r.client.SelinuxusermapDel(&args, &ipa.SelinuxusermapDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa selinux user map: %s", err))
+		return
+	}
+}
+
+func (r *SelinuxUsermapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: req.ID,
+	}
+
+	res, err := r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("selinuxuser"), res.Result.Ipaselinuxuser)...)
+	if res.Result.Seealso != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hbac_rule"), res.Result.Seealso)...)
+	}
+	if res.Result.Ipaenabledflag != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), res.Result.Ipaenabledflag)...)
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASelinuxUsermap_full(t *testing.T) {
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"user_u:s0\"",
+	}
+	testSelinuxUsermapModified := map[string]string{
+		"index":        "0",
+		"name":         "\"testacc-selinux-usermap\"",
+		"selinuxuser":  "\"staff_u:s0-s0:c0.c1023\"",
+		"description":  "\"A SELinux user map for acceptance tests\"",
+		"enabled":      "false",
+		"usercategory": "\"all\"",
+		"hostcategory": "\"all\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "name", "testacc-selinux-usermap"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "selinuxuser", "user_u:s0"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermapModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "selinuxuser", "staff_u:s0-s0:c0.c1023"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "description", "A SELinux user map for acceptance tests"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "enabled", "false"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "usercategory", "all"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "hostcategory", "all"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermapModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASelinuxUsermap_hbacrule(t *testing.T) {
+	testHbacPolicy := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hbac-policy\"",
+	}
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"staff_u:s0-s0:c0.c1023\"",
+		"hbac_rule":   "freeipa_hbac_policy.hbacpolicy-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "name", "testacc-selinux-usermap"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap.selinux-usermap-0", "hbac_rule", "testacc-hbac-policy"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAHbacPolicy_resource(testHbacPolicy) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,531 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SelinuxUsermapUserMembershipResource{}
+var _ resource.ResourceWithImportState = &SelinuxUsermapUserMembershipResource{}
+
+func NewSelinuxUsermapUserMembershipResource() resource.Resource {
+	return &SelinuxUsermapUserMembershipResource{}
+}
+
+// SelinuxUsermapUserMembershipResource defines the resource implementation.
+type SelinuxUsermapUserMembershipResource struct {
+	client *ipa.Client
+}
+
+// SelinuxUsermapUserMembershipResourceModel describes the resource data model.
+type SelinuxUsermapUserMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Users      types.List   `tfsdk:"users"`
+	Group      types.String `tfsdk:"group"`
+	Groups     types.List   `tfsdk:"groups"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selinux_usermap_user_membership"
+}
+
+func (r *SelinuxUsermapUserMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("group"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user"),
+			path.MatchRoot("groups"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("users"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("group"),
+			path.MatchRoot("groups"),
+		),
+	}
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA SELinux user map user membership resource.\nAdding a member that already exist in FreeIPA will result in a warning but the member will be added to the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "SELinux user map name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User to add to the SELinux user map",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				MarkdownDescription: "List of users to add to the SELinux user map",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "User group to add to the SELinux user map",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "List of user groups to add to the SELinux user map",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier to differentiate multiple SELinux user map user membership resources on the same SELinux user map. Mandatory for using users/groups configurations.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SelinuxUsermapUserMembershipResourceModel
+	var id, user_id string
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.SelinuxusermapAddUserOptionalArgs{}
+
+	args := ipa.SelinuxusermapAddUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+	if !data.User.IsNull() {
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+		user_id = "u"
+	}
+	if !data.Group.IsNull() {
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+		user_id = "g"
+	}
+	if !data.Users.IsNull() || !data.Groups.IsNull() {
+		if !data.Users.IsNull() {
+			var v []string
+			for _, value := range data.Users.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			var v []string
+			for _, value := range data.Groups.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Group = &v
+		}
+		user_id = "mu"
+	}
+
+	_v, err := r.client.SelinuxusermapAddUser(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa selinux user map user membership: %s", err))
+		return
+	}
+	if _v.Completed == 0 {
+		resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa selinux user map user membership: %v", _v.Failed))
+	}
+
+	switch user_id {
+	case "u":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), user_id, data.User.ValueString())
+		data.Id = types.StringValue(id)
+	case "g":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), user_id, data.Group.ValueString())
+		data.Id = types.StringValue(id)
+	case "mu":
+		id = fmt.Sprintf("%s/%s/%s", encodeSlash(data.Name.ValueString()), user_id, data.Identifier.ValueString())
+		data.Id = types.StringValue(id)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SelinuxUsermapUserMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usermapid, typeId, memberId, err := parseSelinuxUsermapUserMembershipID(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_selinux_usermap_user_membership: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: usermapid,
+	}
+
+	res, err := r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+			return
+		}
+	}
+
+	switch typeId {
+	case "u":
+		if res.Result.MemberuserUser == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserUser, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map user membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "g":
+		if res.Result.MemberuserGroup == nil || !isStringListContainsCaseInsensistive(res.Result.MemberuserGroup, &memberId) {
+			tflog.Debug(ctx, "[DEBUG] SELinux user map user group membership does not exist")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	case "mu":
+		if !data.Users.IsNull() {
+			var changedVals []string
+			for _, value := range data.Users.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map user member failed with error %s", err))
+				}
+				if res.Result.MemberuserUser != nil && isStringListContainsCaseInsensistive(res.Result.MemberuserUser, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map user member %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.Users, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
+		if !data.Groups.IsNull() {
+			var changedVals []string
+			for _, value := range data.Groups.Elements() {
+				val, err := strconv.Unquote(value.String())
+				if err != nil {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map member commands failed with error %s", err))
+				}
+				if res.Result.MemberuserGroup != nil && isStringListContainsCaseInsensistive(res.Result.MemberuserGroup, &val) {
+					tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa selinux user map member commands %s is present in results", val))
+					changedVals = append(changedVals, val)
+				}
+			}
+			var diag diag.Diagnostics
+			data.Groups, diag = types.ListValueFrom(ctx, types.StringType, &changedVals)
+			if diag.HasError() {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SelinuxUsermapUserMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAddOptArgs := ipa.SelinuxusermapAddUserOptionalArgs{}
+
+	memberAddArgs := ipa.SelinuxusermapAddUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+
+	memberDelOptArgs := ipa.SelinuxusermapRemoveUserOptionalArgs{}
+
+	memberDelArgs := ipa.SelinuxusermapRemoveUserArgs{
+		Cn: data.Name.ValueString(),
+	}
+	hasMemberAdd := false
+	hasMemberDel := false
+	// Memberships can be added or removed, comparing the current state and the plan allows us to define 2 lists of members to add or remove.
+	if !data.Users.Equal(state.Users) {
+		var statearr, planarr, addedUsers, deletedUsers []string
+
+		for _, value := range state.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Users.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedUsers = append(addedUsers, val)
+				memberAddOptArgs.User = &addedUsers
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedUsers = append(deletedUsers, value)
+				memberDelOptArgs.User = &deletedUsers
+				hasMemberDel = true
+			}
+		}
+
+	}
+	if !data.Groups.Equal(state.Groups) {
+		var statearr, planarr, addedGroups, deletedGroups []string
+
+		for _, value := range state.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			statearr = append(statearr, val)
+		}
+		for _, value := range data.Groups.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			planarr = append(planarr, val)
+			if !slices.Contains(statearr, val) {
+				addedGroups = append(addedGroups, val)
+				memberAddOptArgs.Group = &addedGroups
+				hasMemberAdd = true
+			}
+		}
+		for _, value := range statearr {
+			if !slices.Contains(planarr, value) {
+				deletedGroups = append(deletedGroups, value)
+				memberDelOptArgs.Group = &deletedGroups
+				hasMemberDel = true
+			}
+		}
+
+	}
+	// The api provides a add and a remove function for membership. Therefore we need to call the right one when appropriate.
+	if hasMemberAdd {
+		_v, err := r.client.SelinuxusermapAddUser(&memberAddArgs, &memberAddOptArgs)
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Error creating freeipa selinux user map user membership: %s", _v.String()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa selinux user map user membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning creating freeipa selinux user map user membership: %v", _v.Failed))
+		}
+	}
+	if hasMemberDel {
+		_v, err := r.client.SelinuxusermapRemoveUser(&memberDelArgs, &memberDelOptArgs)
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Error removing freeipa selinux user map user membership: %s", _v.String()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa selinux user map user membership: %s", err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa selinux user map user membership: %v", _v.Failed))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SelinuxUsermapUserMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SelinuxUsermapUserMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	usermapId, typeId, _, err := parseSelinuxUsermapUserMembershipID(data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error parsing ID of freeipa_selinux_usermap_user_membership: %s", err))
+		return
+	}
+
+	optArgs := ipa.SelinuxusermapRemoveUserOptionalArgs{}
+
+	args := ipa.SelinuxusermapRemoveUserArgs{
+		Cn: usermapId,
+	}
+
+	switch typeId {
+	case "u":
+		v := []string{data.User.ValueString()}
+		optArgs.User = &v
+	case "g":
+		v := []string{data.Group.ValueString()}
+		optArgs.Group = &v
+	case "mu":
+		if !data.Users.IsNull() {
+			var v []string
+			for _, value := range data.Users.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.User = &v
+		}
+		if !data.Groups.IsNull() {
+			var v []string
+			for _, value := range data.Groups.Elements() {
+				val, _ := strconv.Unquote(value.String())
+				v = append(v, val)
+			}
+			optArgs.Group = &v
+		}
+	}
+
+	_, err = r.client.SelinuxusermapRemoveUser(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error delete freeipa selinux user map user membership: %s", err))
+		return
+	}
+}
+
+func (r *SelinuxUsermapUserMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	usermapId, typeId, memberId, err := parseSelinuxUsermapUserMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error parsing ID for import: %s", err))
+		return
+	}
+
+	all := true
+	optArgs := ipa.SelinuxusermapShowOptionalArgs{
+		All: &all,
+	}
+	args := ipa.SelinuxusermapShowArgs{
+		Cn: usermapId,
+	}
+
+	_, err = r.client.SelinuxusermapShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			resp.Diagnostics.AddError("Import Error", "SELinux user map not found")
+			return
+		}
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Error reading freeipa selinux user map: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), usermapId)...)
+
+	switch typeId {
+	case "u":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), memberId)...)
+	case "g":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), memberId)...)
+	case "mu":
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), memberId)...)
+	default:
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unknown type %s in ID %s", typeId, req.ID))
+	}
+}
+
+func parseSelinuxUsermapUserMembershipID(id string) (string, string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 3 {
+		return "", "", "", fmt.Errorf("unable to determine user membership ID %s", id)
+	}
+
+	name := decodeSlash(idParts[0])
+	_type := idParts[1]
+	user := idParts[2]
+
+	return name, _type, user, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPASelinuxUsermapUserMembership_simple(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"staff_u:s0-s0:c0.c1023\"",
+	}
+	testUserMembership := map[string]string{
+		"index": "0",
+		"name":  "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testGroupMembership := map[string]string{
+		"index": "1",
+		"name":  "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"group": "freeipa_group.group-0.name",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapUserMembership_resource(testUserMembership) + testAccFreeIPASelinuxUsermapUserMembership_resource(testGroupMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "name", "testacc-selinux-usermap"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "user", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-1", "group", "testacc-group-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapUserMembership_resource(testUserMembership) + testAccFreeIPASelinuxUsermapUserMembership_resource(testGroupMembership),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccFreeIPASelinuxUsermapUserMembership_multiple(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group-0\"",
+	}
+	testSelinuxUsermap := map[string]string{
+		"index":       "0",
+		"name":        "\"testacc-selinux-usermap\"",
+		"selinuxuser": "\"staff_u:s0-s0:c0.c1023\"",
+	}
+	testMembership := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"identifier": "\"users-0\"",
+	}
+	testMembershipModified := map[string]string{
+		"index":      "0",
+		"name":       "freeipa_selinux_usermap.selinux-usermap-0.name",
+		"groups":     "[freeipa_group.group-0.name]",
+		"identifier": "\"users-0\"",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapUserMembership_resource(testMembership),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "users.0", "testacc-user-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapUserMembership_resource(testMembershipModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "users"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "groups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_selinux_usermap_user_membership.selinux-usermap-user-membership-0", "groups.0", "testacc-group-0"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPASelinuxUsermap_resource(testSelinuxUsermap) + testAccFreeIPASelinuxUsermapUserMembership_resource(testMembershipModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+func NewServiceAllowCreateKeytabResource() resource.Resource {
+	return &KeytabPermissionResource{
+		object:          "service",
+		operation:       "create",
+		nameDescription: "Service principal (e.g. `HTTP/web.example.test`)",
+		allow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.ServiceAllowCreateKeytab(&ipa.ServiceAllowCreateKeytabArgs{Krbcanonicalname: name}, &ipa.ServiceAllowCreateKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		disallow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.ServiceDisallowCreateKeytab(&ipa.ServiceDisallowCreateKeytabArgs{Krbcanonicalname: name}, &ipa.ServiceDisallowCreateKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		show: showServiceKeytabMembers,
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAServiceAllowCreateKeytab_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHost2 := map[string]string{
+		"index":      "1",
+		"name":       "\"testacc-host-2.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.66\"",
+	}
+	testHostGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-hostgroup\"",
+	}
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-group\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testKeytabUser := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+		"user":  "freeipa_user.user-0.name",
+	}
+	testKeytabGroup := map[string]string{
+		"index": "1",
+		"name":  "freeipa_service.service-0.name",
+		"group": "freeipa_group.group-0.name",
+	}
+	testKeytabMembers := map[string]string{
+		"index":      "2",
+		"name":       "freeipa_service.service-0.name",
+		"hosts":      "[freeipa_host.host-1.name]",
+		"hostgroups": "[freeipa_hostgroup.hostgroup-0.name]",
+		"identifier": "\"automation\"",
+	}
+	testKeytabMembersModified := map[string]string{
+		"index":      "2",
+		"name":       "freeipa_service.service-0.name",
+		"users":      "[freeipa_user.user-0.name]",
+		"hostgroups": "[]",
+		"identifier": "\"automation\"",
+	}
+
+	baseConfig := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHost_resource(testHost2) + testAccFreeIPAHostGroup_resource(testHostGroup) + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAGroup_resource(testGroup) + testAccFreeIPAService_resource(testService)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabUser) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabGroup) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-0", "user", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-1", "group", "testacc-group"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hosts.0", "testacc-host-2.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hostgroups.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hostgroups.0", "testacc-hostgroup"),
+				),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabUser) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabGroup) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabMembers),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: baseConfig + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabGroup) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabMembersModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "users.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "users.0", "testacc-user"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hosts.#", "0"),
+					resource.TestCheckResourceAttr("freeipa_service_allow_create_keytab.keytab-2", "hostgroups.#", "0"),
+				),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabGroup) + testAccFreeIPAServiceAllowCreateKeytab_resource(testKeytabMembersModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+func NewServiceAllowRetrieveKeytabResource() resource.Resource {
+	return &KeytabPermissionResource{
+		object:          "service",
+		operation:       "retrieve",
+		nameDescription: "Service principal (e.g. `HTTP/web.example.test`)",
+		description:     "It conflicts with the `allowed_to_retrieve_keytab_*` attributes of the `freeipa_service` resource.",
+		allow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.ServiceAllowRetrieveKeytab(&ipa.ServiceAllowRetrieveKeytabArgs{Krbcanonicalname: name}, &ipa.ServiceAllowRetrieveKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		disallow: func(client *ipa.Client, name string, members keytabMembers) (*ipa.MemberResult, error) {
+			return client.ServiceDisallowRetrieveKeytab(&ipa.ServiceDisallowRetrieveKeytabArgs{Krbcanonicalname: name}, &ipa.ServiceDisallowRetrieveKeytabOptionalArgs{
+				User:      members.User,
+				Group:     members.Group,
+				Host:      members.Host,
+				Hostgroup: members.Hostgroup,
+			})
+		},
+		show: showServiceKeytabMembers,
+	}
+}
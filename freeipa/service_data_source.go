@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServiceDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServiceDataSource{}
+
+func NewServiceDataSource() datasource.DataSource {
+	return &ServiceDataSource{}
+}
+
+// ServiceDataSource defines the data source implementation.
+type ServiceDataSource struct {
+	client *ipa.Client
+}
+
+// ServiceDataSourceModel describes the data source data model.
+type ServiceDataSourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Principals               types.List   `tfsdk:"principals"`
+	UserCertificates         types.List   `tfsdk:"user_certificates"`
+	KrbAuthIndicator         types.List   `tfsdk:"krb_auth_indicators"`
+	PacType                  types.List   `tfsdk:"pac_type"`
+	KrbPreAuth               types.Bool   `tfsdk:"krb_preauth"`
+	TrustedForDelegation     types.Bool   `tfsdk:"trusted_for_delegation"`
+	TrustedToAuthAsDelegate  types.Bool   `tfsdk:"trusted_to_auth_as_delegate"`
+	ManagedByHosts           types.List   `tfsdk:"managed_by_hosts"`
+	RetrieveKeytabUsers      types.List   `tfsdk:"allowed_to_retrieve_keytab_users"`
+	RetrieveKeytabGroups     types.List   `tfsdk:"allowed_to_retrieve_keytab_groups"`
+	RetrieveKeytabHosts      types.List   `tfsdk:"allowed_to_retrieve_keytab_hosts"`
+	RetrieveKeytabHostgroups types.List   `tfsdk:"allowed_to_retrieve_keytab_hostgroups"`
+	CreateKeytabUsers        types.List   `tfsdk:"allowed_to_create_keytab_users"`
+	CreateKeytabGroups       types.List   `tfsdk:"allowed_to_create_keytab_groups"`
+	CreateKeytabHosts        types.List   `tfsdk:"allowed_to_create_keytab_hosts"`
+	CreateKeytabHostgroups   types.List   `tfsdk:"allowed_to_create_keytab_hostgroups"`
+	HasKeytab                types.Bool   `tfsdk:"has_keytab"`
+}
+
+func (r *ServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Service data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource in the terraform state",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service principal (e.g. `HTTP/web.example.test`). The realm is optional.",
+				Required:            true,
+			},
+			"principals": schema.ListAttribute{
+				MarkdownDescription: "Principal names of the service, aliases included",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"user_certificates": schema.ListAttribute{
+				MarkdownDescription: "Base-64 encoded service certificate",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_auth_indicators": schema.ListAttribute{
+				MarkdownDescription: "Defines a whitelist for Authentication Indicators. Use 'otp' to allow OTP-based 2FA authentications. Use 'radius' to allow RADIUS-based 2FA authentications. Other values may be used for custom configurations.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"pac_type": schema.ListAttribute{
+				MarkdownDescription: "Override default list of supported PAC types.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_preauth": schema.BoolAttribute{
+				MarkdownDescription: "Pre-authentication is required for the service",
+				Computed:            true,
+			},
+			"trusted_for_delegation": schema.BoolAttribute{
+				MarkdownDescription: "Client credentials may be delegated to the service",
+				Computed:            true,
+			},
+			"trusted_to_auth_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "The service is allowed to authenticate on behalf of a client",
+				Computed:            true,
+			},
+			"managed_by_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts that can manage this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_users": schema.ListAttribute{
+				MarkdownDescription: "Users allowed to retrieve the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_groups": schema.ListAttribute{
+				MarkdownDescription: "User groups allowed to retrieve the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts allowed to retrieve the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_hostgroups": schema.ListAttribute{
+				MarkdownDescription: "Host groups allowed to retrieve the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_create_keytab_users": schema.ListAttribute{
+				MarkdownDescription: "Users allowed to create the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_create_keytab_groups": schema.ListAttribute{
+				MarkdownDescription: "User groups allowed to create the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_create_keytab_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts allowed to create the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_create_keytab_hostgroups": schema.ListAttribute{
+				MarkdownDescription: "Host groups allowed to create the keytab of this service",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"has_keytab": schema.BoolAttribute{
+				MarkdownDescription: "Whether a keytab has been created for the service",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.ServiceShowArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+	optArgs := ipa.ServiceShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.ServiceShow(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service %s", data.Name.ValueString()))
+		return
+	}
+
+	if res.Result.Krbprincipalname != nil {
+		data.Principals, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Krbprincipalname)
+	}
+	if res.Result.Usercertificate != nil {
+		var resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			if cert, ok := v.(string); ok {
+				resVals = append(resVals, cert)
+			}
+		}
+		data.UserCertificates, _ = types.ListValueFrom(ctx, types.StringType, resVals)
+	}
+	if res.Result.Krbprincipalauthind != nil {
+		data.KrbAuthIndicator, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Krbprincipalauthind)
+	}
+	if res.Result.Ipakrbauthzdata != nil {
+		data.PacType, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipakrbauthzdata)
+	}
+	if res.Result.Ipakrbrequirespreauth != nil {
+		data.KrbPreAuth = types.BoolValue(*res.Result.Ipakrbrequirespreauth)
+	}
+	if res.Result.Ipakrbokasdelegate != nil {
+		data.TrustedForDelegation = types.BoolValue(*res.Result.Ipakrbokasdelegate)
+	}
+	if res.Result.Ipakrboktoauthasdelegate != nil {
+		data.TrustedToAuthAsDelegate = types.BoolValue(*res.Result.Ipakrboktoauthasdelegate)
+	}
+	if res.Result.ManagedbyHost != nil {
+		data.ManagedByHosts, _ = types.ListValueFrom(ctx, types.StringType, res.Result.ManagedbyHost)
+	}
+	if res.Result.IpaallowedtoperformReadKeysUser != nil {
+		data.RetrieveKeytabUsers, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformReadKeysUser)
+	}
+	if res.Result.IpaallowedtoperformReadKeysGroup != nil {
+		data.RetrieveKeytabGroups, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformReadKeysGroup)
+	}
+	if res.Result.IpaallowedtoperformReadKeysHost != nil {
+		data.RetrieveKeytabHosts, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformReadKeysHost)
+	}
+	if res.Result.IpaallowedtoperformReadKeysHostgroup != nil {
+		data.RetrieveKeytabHostgroups, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformReadKeysHostgroup)
+	}
+	if res.Result.IpaallowedtoperformWriteKeysUser != nil {
+		data.CreateKeytabUsers, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformWriteKeysUser)
+	}
+	if res.Result.IpaallowedtoperformWriteKeysGroup != nil {
+		data.CreateKeytabGroups, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformWriteKeysGroup)
+	}
+	if res.Result.IpaallowedtoperformWriteKeysHost != nil {
+		data.CreateKeytabHosts, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformWriteKeysHost)
+	}
+	if res.Result.IpaallowedtoperformWriteKeysHostgroup != nil {
+		data.CreateKeytabHostgroups, _ = types.ListValueFrom(ctx, types.StringType, res.Result.IpaallowedtoperformWriteKeysHostgroup)
+	}
+	if res.Result.HasKeytab != nil {
+		data.HasKeytab = types.BoolValue(*res.Result.HasKeytab)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.Krbcanonicalname))
+
+	data.Id = types.StringValue(res.Result.Krbcanonicalname)
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,606 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+	"golang.org/x/exp/slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceResource{}
+var _ resource.ResourceWithImportState = &ServiceResource{}
+
+func NewServiceResource() resource.Resource {
+	return &ServiceResource{}
+}
+
+// ServiceResource defines the resource implementation.
+type ServiceResource struct {
+	client *ipa.Client
+}
+
+// ServiceResourceModel describes the resource data model.
+type ServiceResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	UserCertificates         types.List   `tfsdk:"user_certificates"`
+	KrbAuthIndicator         types.List   `tfsdk:"krb_auth_indicators"`
+	PacType                  types.List   `tfsdk:"pac_type"`
+	KrbPreAuth               types.Bool   `tfsdk:"krb_preauth"`
+	TrustedForDelegation     types.Bool   `tfsdk:"trusted_for_delegation"`
+	TrustedToAuthAsDelegate  types.Bool   `tfsdk:"trusted_to_auth_as_delegate"`
+	ManagedByHosts           types.List   `tfsdk:"managed_by_hosts"`
+	RetrieveKeytabUsers      types.List   `tfsdk:"allowed_to_retrieve_keytab_users"`
+	RetrieveKeytabGroups     types.List   `tfsdk:"allowed_to_retrieve_keytab_groups"`
+	RetrieveKeytabHosts      types.List   `tfsdk:"allowed_to_retrieve_keytab_hosts"`
+	RetrieveKeytabHostgroups types.List   `tfsdk:"allowed_to_retrieve_keytab_hostgroups"`
+	Force                    types.Bool   `tfsdk:"force"`
+	SkipHostCheck            types.Bool   `tfsdk:"skip_host_check"`
+}
+
+func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA Service resource.\nThe `allowed_to_retrieve_keytab_*` attributes manage the same FreeIPA lists as the `freeipa_service_allow_retrieve_keytab` resource, only one of them must be used for a given service.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Service principal (e.g. `HTTP/web.example.test`). The realm is optional, adding or removing it does not replace the service.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(servicePrincipalRequiresReplace, "Replaces the service unless only the realm of the principal changes.", "Replaces the service unless only the realm of the principal changes."),
+				},
+			},
+			"user_certificates": schema.ListAttribute{
+				MarkdownDescription: "Base-64 encoded service certificate",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"krb_auth_indicators": schema.ListAttribute{
+				MarkdownDescription: "Defines a whitelist for Authentication Indicators. Use 'otp' to allow OTP-based 2FA authentications. Use 'radius' to allow RADIUS-based 2FA authentications. Other values may be used for custom configurations.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pac_type": schema.ListAttribute{
+				MarkdownDescription: "Override default list of supported PAC types. Use 'NONE' to disable PAC support for this service, e.g. this might be necessary for NFS services.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("MS-PAC", "PAD", "NONE")),
+				},
+			},
+			"krb_preauth": schema.BoolAttribute{
+				MarkdownDescription: "Pre-authentication is required for the service",
+				Optional:            true,
+			},
+			"trusted_for_delegation": schema.BoolAttribute{
+				MarkdownDescription: "Client credentials may be delegated to the service",
+				Optional:            true,
+			},
+			"trusted_to_auth_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "The service is allowed to authenticate on behalf of a client",
+				Optional:            true,
+			},
+			"managed_by_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts that can manage this service. The host of the service always manages it.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_users": schema.ListAttribute{
+				MarkdownDescription: "Users allowed to retrieve the keytab of this service. Conflicts with the `freeipa_service_allow_retrieve_keytab` resource.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_groups": schema.ListAttribute{
+				MarkdownDescription: "User groups allowed to retrieve the keytab of this service. Conflicts with the `freeipa_service_allow_retrieve_keytab` resource.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_hosts": schema.ListAttribute{
+				MarkdownDescription: "Hosts allowed to retrieve the keytab of this service. Conflicts with the `freeipa_service_allow_retrieve_keytab` resource.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"allowed_to_retrieve_keytab_hostgroups": schema.ListAttribute{
+				MarkdownDescription: "Host groups allowed to retrieve the keytab of this service. Conflicts with the `freeipa_service_allow_retrieve_keytab` resource.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Force principal name even if host not in DNS",
+				Optional:            true,
+			},
+			"skip_host_check": schema.BoolAttribute{
+				MarkdownDescription: "Force service to be created even when host object does not exist to manage it",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.ServiceAddOptionalArgs{}
+
+	args := ipa.ServiceAddArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+
+	if !data.UserCertificates.IsNull() {
+		var v []interface{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+	}
+	if !data.KrbAuthIndicator.IsNull() {
+		v := listStringValues(data.KrbAuthIndicator)
+		optArgs.Krbprincipalauthind = &v
+	}
+	if !data.PacType.IsNull() {
+		v := listStringValues(data.PacType)
+		optArgs.Ipakrbauthzdata = &v
+	}
+	if !data.KrbPreAuth.IsNull() {
+		optArgs.Ipakrbrequirespreauth = data.KrbPreAuth.ValueBoolPointer()
+	}
+	if !data.TrustedForDelegation.IsNull() {
+		optArgs.Ipakrbokasdelegate = data.TrustedForDelegation.ValueBoolPointer()
+	}
+	if !data.TrustedToAuthAsDelegate.IsNull() {
+		optArgs.Ipakrboktoauthasdelegate = data.TrustedToAuthAsDelegate.ValueBoolPointer()
+	}
+	if !data.Force.IsNull() {
+		optArgs.Force = data.Force.ValueBoolPointer()
+	}
+	if !data.SkipHostCheck.IsNull() {
+		optArgs.SkipHostCheck = data.SkipHostCheck.ValueBoolPointer()
+	}
+
+	res, err := r.client.ServiceAdd(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error creating freeipa service: %s", err))
+		return
+	}
+	data.Id = types.StringValue(res.Result.Krbcanonicalname)
+
+	if !data.ManagedByHosts.IsNull() {
+		hosts := listStringValues(data.ManagedByHosts)
+		resp.Diagnostics.Append(r.addManagedByHosts(ctx, data.Name.ValueString(), hosts)...)
+	}
+	keytabOptArgs := ipa.ServiceAllowRetrieveKeytabOptionalArgs{}
+	hasKeytabAllow := false
+	if !data.RetrieveKeytabUsers.IsNull() {
+		v := listStringValues(data.RetrieveKeytabUsers)
+		keytabOptArgs.User = &v
+		hasKeytabAllow = true
+	}
+	if !data.RetrieveKeytabGroups.IsNull() {
+		v := listStringValues(data.RetrieveKeytabGroups)
+		keytabOptArgs.Group = &v
+		hasKeytabAllow = true
+	}
+	if !data.RetrieveKeytabHosts.IsNull() {
+		v := listStringValues(data.RetrieveKeytabHosts)
+		keytabOptArgs.Host = &v
+		hasKeytabAllow = true
+	}
+	if !data.RetrieveKeytabHostgroups.IsNull() {
+		v := listStringValues(data.RetrieveKeytabHostgroups)
+		keytabOptArgs.Hostgroup = &v
+		hasKeytabAllow = true
+	}
+	if hasKeytabAllow {
+		resp.Diagnostics.Append(r.allowRetrieveKeytab(ctx, data.Name.ValueString(), &keytabOptArgs)...)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	args := ipa.ServiceShowArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+	optArgs := ipa.ServiceShowOptionalArgs{
+		All: &all,
+	}
+
+	res, err := r.client.ServiceShow(&args, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] Service not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service: %s", err))
+			return
+		}
+	}
+	if res != nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.String()))
+	} else {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa service %s", data.Name.ValueString()))
+		return
+	}
+
+	if !data.UserCertificates.IsNull() && res.Result.Usercertificate != nil {
+		var changedVals, resVals []string
+		for _, v := range *res.Result.Usercertificate {
+			if cert, ok := v.(string); ok {
+				resVals = append(resVals, cert)
+			}
+		}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			if slices.Contains(resVals, val) {
+				changedVals = append(changedVals, val)
+			}
+		}
+		var diag diag.Diagnostics
+		data.UserCertificates, diag = types.ListValueFrom(ctx, types.StringType, changedVals)
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.KrbAuthIndicator.IsNull() && res.Result.Krbprincipalauthind != nil {
+		var diag diag.Diagnostics
+		data.KrbAuthIndicator, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.KrbAuthIndicator, res.Result.Krbprincipalauthind))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.PacType.IsNull() && res.Result.Ipakrbauthzdata != nil {
+		var diag diag.Diagnostics
+		data.PacType, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.PacType, res.Result.Ipakrbauthzdata))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if res.Result.Ipakrbrequirespreauth != nil && !data.KrbPreAuth.IsNull() {
+		data.KrbPreAuth = types.BoolValue(*res.Result.Ipakrbrequirespreauth)
+	}
+	if res.Result.Ipakrbokasdelegate != nil && !data.TrustedForDelegation.IsNull() {
+		data.TrustedForDelegation = types.BoolValue(*res.Result.Ipakrbokasdelegate)
+	}
+	if res.Result.Ipakrboktoauthasdelegate != nil && !data.TrustedToAuthAsDelegate.IsNull() {
+		data.TrustedToAuthAsDelegate = types.BoolValue(*res.Result.Ipakrboktoauthasdelegate)
+	}
+	if !data.ManagedByHosts.IsNull() && res.Result.ManagedbyHost != nil {
+		var diag diag.Diagnostics
+		data.ManagedByHosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.ManagedByHosts, res.Result.ManagedbyHost))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.RetrieveKeytabUsers.IsNull() && res.Result.IpaallowedtoperformReadKeysUser != nil {
+		var diag diag.Diagnostics
+		data.RetrieveKeytabUsers, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.RetrieveKeytabUsers, res.Result.IpaallowedtoperformReadKeysUser))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.RetrieveKeytabGroups.IsNull() && res.Result.IpaallowedtoperformReadKeysGroup != nil {
+		var diag diag.Diagnostics
+		data.RetrieveKeytabGroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.RetrieveKeytabGroups, res.Result.IpaallowedtoperformReadKeysGroup))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.RetrieveKeytabHosts.IsNull() && res.Result.IpaallowedtoperformReadKeysHost != nil {
+		var diag diag.Diagnostics
+		data.RetrieveKeytabHosts, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.RetrieveKeytabHosts, res.Result.IpaallowedtoperformReadKeysHost))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+	if !data.RetrieveKeytabHostgroups.IsNull() && res.Result.IpaallowedtoperformReadKeysHostgroup != nil {
+		var diag diag.Diagnostics
+		data.RetrieveKeytabHostgroups, diag = types.ListValueFrom(ctx, types.StringType, filterStringList(data.RetrieveKeytabHostgroups, res.Result.IpaallowedtoperformReadKeysHostgroup))
+		if diag.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("diag: %v\n", diag))
+		}
+	}
+
+	data.Id = types.StringValue(res.Result.Krbcanonicalname)
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Read freeipa service %s", res.Result.Krbcanonicalname))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := ipa.ServiceModOptionalArgs{}
+
+	args := ipa.ServiceModArgs{
+		Krbcanonicalname: data.Name.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Update freeipa service %s from plan = %v", data.Name.ValueString(), data))
+	if !data.UserCertificates.Equal(state.UserCertificates) {
+		var v []interface{}
+		for _, value := range data.UserCertificates.Elements() {
+			val, _ := strconv.Unquote(value.String())
+			v = append(v, val)
+		}
+		optArgs.Usercertificate = &v
+	}
+	if !data.KrbAuthIndicator.Equal(state.KrbAuthIndicator) {
+		v := listStringValues(data.KrbAuthIndicator)
+		optArgs.Krbprincipalauthind = &v
+	}
+	if !data.PacType.Equal(state.PacType) {
+		v := listStringValues(data.PacType)
+		optArgs.Ipakrbauthzdata = &v
+	}
+	if !data.KrbPreAuth.Equal(state.KrbPreAuth) {
+		if data.KrbPreAuth.ValueBoolPointer() != nil {
+			optArgs.Ipakrbrequirespreauth = data.KrbPreAuth.ValueBoolPointer()
+		} else {
+			v := true
+			optArgs.Ipakrbrequirespreauth = &v
+		}
+	}
+	if !data.TrustedForDelegation.Equal(state.TrustedForDelegation) {
+		if data.TrustedForDelegation.ValueBoolPointer() != nil {
+			optArgs.Ipakrbokasdelegate = data.TrustedForDelegation.ValueBoolPointer()
+		} else {
+			v := false
+			optArgs.Ipakrbokasdelegate = &v
+		}
+	}
+	if !data.TrustedToAuthAsDelegate.Equal(state.TrustedToAuthAsDelegate) {
+		if data.TrustedToAuthAsDelegate.ValueBoolPointer() != nil {
+			optArgs.Ipakrboktoauthasdelegate = data.TrustedToAuthAsDelegate.ValueBoolPointer()
+		} else {
+			v := false
+			optArgs.Ipakrboktoauthasdelegate = &v
+		}
+	}
+
+	_, err := r.client.ServiceMod(&args, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "EmptyModlist") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error updating freeipa service %s: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	// Managed by hosts and keytab permissions are not attributes of service_mod, they are updated with their own commands.
+	if !data.ManagedByHosts.Equal(state.ManagedByHosts) {
+		added, removed := diffStringLists(state.ManagedByHosts, data.ManagedByHosts)
+		if len(added) > 0 {
+			resp.Diagnostics.Append(r.addManagedByHosts(ctx, data.Name.ValueString(), added)...)
+		}
+		if len(removed) > 0 {
+			resp.Diagnostics.Append(r.removeManagedByHosts(ctx, data.Name.ValueString(), removed)...)
+		}
+	}
+
+	allowOptArgs := ipa.ServiceAllowRetrieveKeytabOptionalArgs{}
+	disallowOptArgs := ipa.ServiceDisallowRetrieveKeytabOptionalArgs{}
+	hasAllow := false
+	hasDisallow := false
+	if !data.RetrieveKeytabUsers.Equal(state.RetrieveKeytabUsers) {
+		added, removed := diffStringLists(state.RetrieveKeytabUsers, data.RetrieveKeytabUsers)
+		if len(added) > 0 {
+			allowOptArgs.User = &added
+			hasAllow = true
+		}
+		if len(removed) > 0 {
+			disallowOptArgs.User = &removed
+			hasDisallow = true
+		}
+	}
+	if !data.RetrieveKeytabGroups.Equal(state.RetrieveKeytabGroups) {
+		added, removed := diffStringLists(state.RetrieveKeytabGroups, data.RetrieveKeytabGroups)
+		if len(added) > 0 {
+			allowOptArgs.Group = &added
+			hasAllow = true
+		}
+		if len(removed) > 0 {
+			disallowOptArgs.Group = &removed
+			hasDisallow = true
+		}
+	}
+	if !data.RetrieveKeytabHosts.Equal(state.RetrieveKeytabHosts) {
+		added, removed := diffStringLists(state.RetrieveKeytabHosts, data.RetrieveKeytabHosts)
+		if len(added) > 0 {
+			allowOptArgs.Host = &added
+			hasAllow = true
+		}
+		if len(removed) > 0 {
+			disallowOptArgs.Host = &removed
+			hasDisallow = true
+		}
+	}
+	if !data.RetrieveKeytabHostgroups.Equal(state.RetrieveKeytabHostgroups) {
+		added, removed := diffStringLists(state.RetrieveKeytabHostgroups, data.RetrieveKeytabHostgroups)
+		if len(added) > 0 {
+			allowOptArgs.Hostgroup = &added
+			hasAllow = true
+		}
+		if len(removed) > 0 {
+			disallowOptArgs.Hostgroup = &removed
+			hasDisallow = true
+		}
+	}
+	if hasAllow {
+		resp.Diagnostics.Append(r.allowRetrieveKeytab(ctx, data.Name.ValueString(), &allowOptArgs)...)
+	}
+	if hasDisallow {
+		_v, err := r.client.ServiceDisallowRetrieveKeytab(&ipa.ServiceDisallowRetrieveKeytabArgs{Krbcanonicalname: data.Name.ValueString()}, &disallowOptArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error disallowing keytab retrieval of freeipa service %s: %s", data.Name.ValueString(), err))
+			return
+		}
+		if _v.Completed == 0 {
+			resp.Diagnostics.AddWarning("Client Warning", fmt.Sprintf("Warning disallowing keytab retrieval of freeipa service %s: %v", data.Name.ValueString(), _v.Failed))
+		}
+	}
+
+	data.Id = state.Id
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete freeipa service Id %s", data.Id.ValueString()))
+	args := ipa.ServiceDelArgs{
+		Krbcanonicalname: []string{data.Name.ValueString()},
+	}
+	_, err := r.client.ServiceDel(&args, &ipa.ServiceDelOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("[DEBUG] Service %s deletion failed: %s", data.Id.ValueString(), err))
+		return
+	}
+}
+
+func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// servicePrincipalWithoutRealm returns the service principal without its realm (e.g. HTTP/web.example.test@EXAMPLE.TEST).
+func servicePrincipalWithoutRealm(principal string) string {
+	name, _, _ := strings.Cut(principal, "@")
+	return name
+}
+
+// servicePrincipalRequiresReplace only replaces the service when the principal changes, not its realm.
+func servicePrincipalRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = servicePrincipalWithoutRealm(req.StateValue.ValueString()) != servicePrincipalWithoutRealm(req.PlanValue.ValueString())
+}
+
+func (r *ServiceResource) addManagedByHosts(ctx context.Context, name string, hosts []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Add managed by hosts %v to freeipa service %s", hosts, name))
+	_v, err := r.client.ServiceAddHost(&ipa.ServiceAddHostArgs{Krbcanonicalname: name}, &ipa.ServiceAddHostOptionalArgs{Host: &hosts})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error adding managed by hosts to freeipa service %s: %s", name, err))
+		return diags
+	}
+	if _v.Completed == 0 {
+		diags.AddWarning("Client Warning", fmt.Sprintf("Warning adding managed by hosts to freeipa service %s: %v", name, _v.Failed))
+	}
+	return diags
+}
+
+func (r *ServiceResource) removeManagedByHosts(ctx context.Context, name string, hosts []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Remove managed by hosts %v from freeipa service %s", hosts, name))
+	_v, err := r.client.ServiceRemoveHost(&ipa.ServiceRemoveHostArgs{Krbcanonicalname: name}, &ipa.ServiceRemoveHostOptionalArgs{Host: &hosts})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error removing managed by hosts from freeipa service %s: %s", name, err))
+		return diags
+	}
+	if _v.Completed == 0 {
+		diags.AddWarning("Client Warning", fmt.Sprintf("Warning removing managed by hosts from freeipa service %s: %v", name, _v.Failed))
+	}
+	return diags
+}
+
+func (r *ServiceResource) allowRetrieveKeytab(ctx context.Context, name string, optArgs *ipa.ServiceAllowRetrieveKeytabOptionalArgs) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Allow keytab retrieval of freeipa service %s", name))
+	_v, err := r.client.ServiceAllowRetrieveKeytab(&ipa.ServiceAllowRetrieveKeytabArgs{Krbcanonicalname: name}, optArgs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error allowing keytab retrieval of freeipa service %s: %s", name, err))
+		return diags
+	}
+	if _v.Completed == 0 {
+		diags.AddWarning("Client Warning", fmt.Sprintf("Warning allowing keytab retrieval of freeipa service %s: %v", name, _v.Failed))
+	}
+	return diags
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAService_full(t *testing.T) {
+	testZone := map[string]string{
+		"index":     "0",
+		"zone_name": "\"testacc.ipatest.lan\"",
+	}
+	testHost := map[string]string{
+		"index":      "0",
+		"name":       "\"testacc-host-1.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.65\"",
+	}
+	testHost2 := map[string]string{
+		"index":      "1",
+		"name":       "\"testacc-host-2.${freeipa_dns_zone.dns-zone-0.zone_name}\"",
+		"ip_address": "\"192.168.10.66\"",
+	}
+	testGroup := map[string]string{
+		"index": "0",
+		"name":  "\"testacc-keytab-readers\"",
+	}
+	testService := map[string]string{
+		"index": "0",
+		"name":  "\"HTTP/${freeipa_host.host-0.name}\"",
+	}
+	testServiceModified := map[string]string{
+		"index":                             "0",
+		"name":                              "\"HTTP/${freeipa_host.host-0.name}\"",
+		"krb_auth_indicators":               "[\"otp\"]",
+		"pac_type":                          "[\"MS-PAC\"]",
+		"trusted_for_delegation":            "true",
+		"managed_by_hosts":                  "[freeipa_host.host-1.name]",
+		"allowed_to_retrieve_keytab_groups": "[freeipa_group.group-0.name]",
+		"allowed_to_retrieve_keytab_hosts":  "[freeipa_host.host-1.name]",
+	}
+	testServiceModified2 := map[string]string{
+		"index":                             "0",
+		"name":                              "\"HTTP/${freeipa_host.host-0.name}\"",
+		"krb_auth_indicators":               "[\"otp\", \"radius\"]",
+		"pac_type":                          "[\"NONE\"]",
+		"trusted_for_delegation":            "false",
+		"managed_by_hosts":                  "[]",
+		"allowed_to_retrieve_keytab_groups": "[freeipa_group.group-0.name]",
+		"allowed_to_retrieve_keytab_hosts":  "[]",
+	}
+	testServiceRealm := map[string]string{
+		"index":                             "0",
+		"name":                              "\"HTTP/${freeipa_host.host-0.name}@IPATEST.LAN\"",
+		"krb_auth_indicators":               "[\"otp\", \"radius\"]",
+		"pac_type":                          "[\"NONE\"]",
+		"trusted_for_delegation":            "false",
+		"managed_by_hosts":                  "[]",
+		"allowed_to_retrieve_keytab_groups": "[freeipa_group.group-0.name]",
+		"allowed_to_retrieve_keytab_hosts":  "[]",
+	}
+	testServiceDS := map[string]string{
+		"index": "0",
+		"name":  "freeipa_service.service-0.name",
+	}
+
+	baseConfig := testAccFreeIPAProvider() + testAccFreeIPADNSZone_resource(testZone) + testAccFreeIPAHost_resource(testHost) + testAccFreeIPAHost_resource(testHost2) + testAccFreeIPAGroup_resource(testGroup)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + testAccFreeIPAService_resource(testService),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "name", "HTTP/testacc-host-1.testacc.ipatest.lan"),
+				),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAService_resource(testServiceModified) + testAccFreeIPAService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_auth_indicators.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_auth_indicators.0", "otp"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "pac_type.0", "MS-PAC"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "trusted_for_delegation", "true"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "managed_by_hosts.#", "1"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "managed_by_hosts.0", "testacc-host-2.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "allowed_to_retrieve_keytab_groups.0", "testacc-keytab-readers"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "allowed_to_retrieve_keytab_hosts.0", "testacc-host-2.testacc.ipatest.lan"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "krb_auth_indicators.0", "otp"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "trusted_for_delegation", "true"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "managed_by_hosts.#", "2"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "allowed_to_retrieve_keytab_groups.0", "testacc-keytab-readers"),
+				),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAService_resource(testServiceModified) + testAccFreeIPAService_datasource(testServiceDS),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: baseConfig + testAccFreeIPAService_resource(testServiceModified2) + testAccFreeIPAService_datasource(testServiceDS),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "krb_auth_indicators.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "pac_type.0", "NONE"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "trusted_for_delegation", "false"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "managed_by_hosts.#", "0"),
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "allowed_to_retrieve_keytab_hosts.#", "0"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "managed_by_hosts.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_service.service-0", "allowed_to_retrieve_keytab_hosts.#", "0"),
+				),
+			},
+			{
+				// Adding the realm to the principal does not replace the service.
+				Config: baseConfig + testAccFreeIPAService_resource(testServiceRealm),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("freeipa_service.service-0", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.service-0", "name", "HTTP/testacc-host-1.testacc.ipatest.lan@IPATEST.LAN"),
+				),
+			},
+			{
+				Config: baseConfig + testAccFreeIPAService_resource(testServiceRealm),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ipa "github.com/infra-monkey/go-freeipa/freeipa"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserPasskeyResource{}
+var _ resource.ResourceWithImportState = &UserPasskeyResource{}
+
+func NewUserPasskeyResource() resource.Resource {
+	return &UserPasskeyResource{}
+}
+
+// UserPasskeyResource defines the resource implementation.
+type UserPasskeyResource struct {
+	client *ipa.Client
+}
+
+// UserPasskeyResourceModel describes the resource data model.
+type UserPasskeyResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	User     types.String `tfsdk:"user"`
+	Passkeys types.List   `tfsdk:"passkeys"`
+}
+
+func (r *UserPasskeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_passkey"
+}
+
+func (r *UserPasskeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "FreeIPA user passkey mappings resource.\nThe passkey mappings are generated with `ipa user-add-passkey --register` or `sssctl passkey-register`. Only the mappings managed by this resource are read and removed, the mappings registered outside of Terraform are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user owning the passkeys",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"passkeys": schema.ListAttribute{
+				MarkdownDescription: "Passkey mappings of the user (ex: `passkey:<credential id>,<public key>`)",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^passkey:`), "must be a passkey mapping starting with passkey:")),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserPasskeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipa.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserPasskeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserPasskeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.UserAddPasskeyArgs{
+		Ipapasskey: listStringValues(data.Passkeys),
+	}
+	optArgs := ipa.UserAddPasskeyOptionalArgs{
+		UID: data.User.ValueStringPointer(),
+	}
+	_, err := r.client.UserAddPasskey(&args, &optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding freeipa user passkeys: %s", err))
+		return
+	}
+	data.Id = data.User
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasskeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserPasskeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all := true
+	optArgs := ipa.UserShowOptionalArgs{
+		UID: data.User.ValueStringPointer(),
+		All: &all,
+	}
+
+	res, err := r.client.UserShow(&ipa.UserShowArgs{}, &optArgs)
+	if err != nil {
+		if strings.Contains(err.Error(), "NotFound") {
+			tflog.Debug(ctx, "[DEBUG] User not found")
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error reading freeipa user passkeys: %s", err))
+			return
+		}
+	}
+
+	data.Id = data.User
+	if data.Passkeys.IsNull() {
+		// On import, all the passkey mappings of the user are managed.
+		data.Passkeys, _ = types.ListValueFrom(ctx, types.StringType, res.Result.Ipapasskey)
+	} else {
+		passkeys := filterStringList(data.Passkeys, res.Result.Ipapasskey)
+		if len(passkeys) == 0 {
+			tflog.Debug(ctx, "[DEBUG] User passkeys not found")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Passkeys, _ = types.ListValueFrom(ctx, types.StringType, passkeys)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasskeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserPasskeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Passkeys can be added or removed, comparing the current state and the plan allows us to define 2 lists of passkeys to add or remove.
+	addedPasskeys, removedPasskeys := diffStringLists(state.Passkeys, data.Passkeys)
+	if len(addedPasskeys) > 0 {
+		_, err := r.client.UserAddPasskey(&ipa.UserAddPasskeyArgs{Ipapasskey: addedPasskeys}, &ipa.UserAddPasskeyOptionalArgs{UID: data.User.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error adding freeipa user passkeys: %s", err))
+			return
+		}
+	}
+	if len(removedPasskeys) > 0 {
+		_, err := r.client.UserRemovePasskey(&ipa.UserRemovePasskeyArgs{Ipapasskey: removedPasskeys}, &ipa.UserRemovePasskeyOptionalArgs{UID: data.User.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa user passkeys: %s", err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserPasskeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserPasskeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := ipa.UserRemovePasskeyArgs{
+		Ipapasskey: listStringValues(data.Passkeys),
+	}
+	optArgs := ipa.UserRemovePasskeyOptionalArgs{
+		UID: data.User.ValueStringPointer(),
+	}
+	_, err := r.client.UserRemovePasskey(&args, &optArgs)
+	if err != nil && !strings.Contains(err.Error(), "NotFound") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error removing freeipa user passkeys: %s", err))
+		return
+	}
+}
+
+func (r *UserPasskeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("user"), req, resp)
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: GPL-3.0-only
+
+package freeipa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFreeIPAUserPasskey_full(t *testing.T) {
+	testUser := map[string]string{
+		"index":     "0",
+		"login":     "\"testacc-user-0\"",
+		"firstname": "\"Test\"",
+		"lastname":  "\"User0\"",
+	}
+	passkey0 := "\"passkey:5Ydrqxoi2s0bRhKyFi6lN8hHmmydqJ+N1lVhoY+iwlE+C85oe1FWgbeZtc6/fHJF4gsXhXxDzNj+UAgC5zJyZg==,MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEiSXtkIgRdS0iAWjD19w4w+sH1oQEJXdSQ/9Yw2pbj2q17R8qUrb2RAUsbidT6F+JbMFYlwvFh8K3SrLS/4ULpA==\""
+	passkey1 := "\"passkey:d2hhdGV2ZXItY3JlZGVudGlhbC1pZC1vZi10aGUtc2Vjb25kLWtleQ==,MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEc8ZTW1ZTTR3S5H9lGwT9iZrGdx7y7FyaVoQqPHzgwB0Hd5cT6NFw3Q8XyT7P1ucs2F8Z1q0D5Fh0ZqYfSgw9dA==\""
+	testUserPasskey := map[string]string{
+		"index":    "0",
+		"user":     "freeipa_user.user-0.name",
+		"passkeys": "[" + passkey0 + "]",
+	}
+	testUserPasskeyModified := map[string]string{
+		"index":    "0",
+		"user":     "freeipa_user.user-0.name",
+		"passkeys": "[" + passkey0 + ", " + passkey1 + "]",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserPasskey_resource(testUserPasskey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user_passkey.user-passkey-0", "id", "testacc-user-0"),
+					resource.TestCheckResourceAttr("freeipa_user_passkey.user-passkey-0", "passkeys.#", "1"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserPasskey_resource(testUserPasskey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserPasskey_resource(testUserPasskeyModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user_passkey.user-passkey-0", "passkeys.#", "2"),
+				),
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserPasskey_resource(testUserPasskeyModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccFreeIPAProvider() + testAccFreeIPAUser_resource(testUser) + testAccFreeIPAUserPasskey_resource(testUserPasskey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user_passkey.user-passkey-0", "passkeys.#", "1"),
+				),
+			},
+		},
+	})
+}
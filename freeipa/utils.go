@@ -7,11 +7,26 @@
 package freeipa
 
 import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/exp/slices"
 )
 
+const pemHeaderPrefix = "-----BEGIN"
+
 func getEnvAsBool(name string, defaultVal bool) bool {
 	valStr := os.Getenv(name)
 	if val, err := strconv.ParseBool(valStr); err == nil {
@@ -38,3 +53,203 @@ func isStringListContainsCaseInsensistive(strList *[]string, str *string) bool {
 	}
 	return false
 }
+
+// readPEM returns PEM content given inline or as a path to a file holding it.
+// The inline content is not part of the errors as it can hold a private key.
+func readPEM(value string) ([]byte, error) {
+	content := []byte(value)
+	source := "the inline value"
+	if !strings.Contains(value, pemHeaderPrefix) {
+		var err error
+		content, err = os.ReadFile(value)
+		if err != nil {
+			return nil, err
+		}
+		source = value
+	}
+	if block, _ := pem.Decode(content); block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", source)
+	}
+	return content, nil
+}
+
+// listStringValues returns the values of a list of strings.
+func listStringValues(list types.List) []string {
+	var values []string
+	for _, value := range list.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		values = append(values, val)
+	}
+	return values
+}
+
+// diffStringLists returns the values of the plan missing from the state and the values of the state missing from the plan.
+func diffStringLists(state types.List, plan types.List) ([]string, []string) {
+	var added, removed []string
+	statearr := listStringValues(state)
+	planarr := listStringValues(plan)
+	for _, value := range planarr {
+		if !slices.Contains(statearr, value) {
+			added = append(added, value)
+		}
+	}
+	for _, value := range statearr {
+		if !slices.Contains(planarr, value) {
+			removed = append(removed, value)
+		}
+	}
+	return added, removed
+}
+
+// filterStringList returns the values of the list that are also found in the values read from FreeIPA.
+// Values that FreeIPA adds on its own (e.g. the default ones) are ignored to avoid drifts.
+func filterStringList(list types.List, values *[]string) []string {
+	var filtered []string
+	if values == nil {
+		return filtered
+	}
+	for _, val := range listStringValues(list) {
+		if isStringListContainsCaseInsensistive(values, &val) {
+			filtered = append(filtered, val)
+		}
+	}
+	return filtered
+}
+
+// intPointer returns the value of an int64 attribute as expected by the FreeIPA API, nil when the attribute is null or unknown.
+func intPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := int(value.ValueInt64())
+	return &v
+}
+
+// int64Value returns an int64 attribute from an integer read from FreeIPA, null when FreeIPA did not return it.
+func int64Value(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// appendSetattr adds an attribute/value pair to the setattr, addattr or delattr argument of a FreeIPA command.
+func appendSetattr(setattr *[]string, value string) *[]string {
+	var v []string
+	if setattr != nil {
+		v = *setattr
+	}
+	v = append(v, value)
+	return &v
+}
+
+// setStringValues returns the values of a set of strings.
+func setStringValues(set types.Set) []string {
+	var values []string
+	for _, value := range set.Elements() {
+		val, _ := strconv.Unquote(value.String())
+		values = append(values, val)
+	}
+	return values
+}
+
+// keepStringSetCase returns the values read from FreeIPA, spelled as in the set when they only differ by their case.
+// FreeIPA lowercases some values (e.g. attribute names), this avoids drifts on the configurations using another case.
+func keepStringSetCase(set types.Set, values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	configured := setStringValues(set)
+	var result []string
+	for _, val := range *values {
+		for _, c := range configured {
+			if strings.EqualFold(c, val) {
+				val = c
+				break
+			}
+		}
+		result = append(result, val)
+	}
+	return result
+}
+
+// configuredAttributesKey is the private state key listing the attributes set in the configuration.
+const configuredAttributesKey = "configured_attributes"
+
+// setConfiguredAttributes records in the private state the attributes set in the configuration,
+// for useStateUnlessRemoved to detect their removal.
+func setConfiguredAttributes(ctx context.Context, config tfsdk.Config, private interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var attributes map[string]tftypes.Value
+	if err := config.Raw.As(&attributes); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading the configuration: %s", err))
+		return diags
+	}
+	names := []string{}
+	for name, value := range attributes {
+		if !value.IsNull() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	value, err := json.Marshal(names)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error recording the configured attributes: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, configuredAttributesKey, value)
+}
+
+// useStateUnlessRemoved returns a plan modifier keeping the prior state value of an optional and computed
+// attribute not set in the configuration. The value of an attribute removed from the configuration is left
+// unknown instead, for the resource to reset it.
+func useStateUnlessRemoved() useStateUnlessRemovedModifier {
+	return useStateUnlessRemovedModifier{}
+}
+
+type useStateUnlessRemovedModifier struct{}
+
+func (m useStateUnlessRemovedModifier) Description(ctx context.Context) string {
+	return "Keeps the prior state value unless the attribute was removed from the configuration."
+}
+
+func (m useStateUnlessRemovedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateUnlessRemovedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !m.removed(ctx, req.Path, req.Private, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m useStateUnlessRemovedModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !m.removed(ctx, req.Path, req.Private, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// removed returns true when the attribute was set in the configuration of the last apply.
+func (m useStateUnlessRemovedModifier) removed(ctx context.Context, attribute path.Path, private interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}, diags *diag.Diagnostics) bool {
+	value, getDiags := private.GetKey(ctx, configuredAttributesKey)
+	diags.Append(getDiags...)
+	if value == nil {
+		return false
+	}
+	var names []string
+	if err := json.Unmarshal(value, &names); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Error reading the configured attributes: %s", err))
+		return false
+	}
+	return slices.Contains(names, attribute.String())
+}